@@ -16,6 +16,12 @@ type PanopticAppSetting struct {
 	LAMBDA_LIFE_SPAN_SECOND int64 `yaml:"LAMBDA_LIFE_SPAN_SECOND"`
 	// Maintain the lambda pool every other interval.
 	MAINTAIN_EVERY_SECOND int64 `yaml:"MAINTAIN_EVERY_SECOND"`
+	// Max number of Lambda invocations of a job, including the first one.
+	LAMBDA_MAX_ATTEMPTS int `yaml:"LAMBDA_MAX_ATTEMPTS"`
+	// Backoff before the first retry of a failed Lambda invocation.
+	LAMBDA_RETRY_BACKOFF_MILLISECOND int64 `yaml:"LAMBDA_RETRY_BACKOFF_MILLISECOND"`
+	// Max number of Lambda retries of each config per hour.
+	LAMBDA_RETRY_BUDGET_PER_CONFIG_PER_HOUR int `yaml:"LAMBDA_RETRY_BUDGET_PER_CONFIG_PER_HOUR"`
 	// Force to use remote config file, instead of using local Panoptic schedule.
 	// Otherwise, we use remote fetch for production config, and use local for
 	// dev and testing.
@@ -28,6 +34,77 @@ type PanopticAppSetting struct {
 	// execute on Lambda (though it won't be published to SNS due to Collector's
 	// debug mode handling)
 	DO_NOT_EXECUTE_ON_LAMBDA_FOR_DEBUG_JOB bool `yaml:"DO_NOT_EXECUTE_ON_LAMBDA_FOR_DEBUG_JOB"`
+	// Layered sources of Panoptic config, a config in a later source overrides
+	// the config with the same name in earlier sources. If empty, customized
+	// sources in DB are layered under Github config (production or forced
+	// remote pull) or LOCAL_PANOPTIC_CONFIG_PATH.
+	CONFIG_SOURCES []ConfigSourceSetting `yaml:"CONFIG_SOURCES"`
+	// Where jobs are executed, "lambda" (default), "local" or "remote".
+	EXECUTOR string `yaml:"EXECUTOR"`
+	// Max number of jobs local executor runs at the same time.
+	LOCAL_EXECUTOR_POOL_SIZE int `yaml:"LOCAL_EXECUTOR_POOL_SIZE"`
+	// Timeout of a job executed by local executor, no timeout if not positive.
+	LOCAL_EXECUTOR_JOB_TIMEOUT_SECOND int64 `yaml:"LOCAL_EXECUTOR_JOB_TIMEOUT_SECOND"`
+	// If set, local executor runs each job in a subprocess started by this
+	// command, e.g. ["go", "run", "cmd/collector/main.go", "-mode=stdin"].
+	// Otherwise jobs run in the panoptic process.
+	LOCAL_EXECUTOR_SUBPROCESS_COMMAND []string `yaml:"LOCAL_EXECUTOR_SUBPROCESS_COMMAND"`
+	// Base urls of remote collector workers running cmd/collector in http
+	// mode, used by remote executor.
+	REMOTE_EXECUTOR_WORKERS []string `yaml:"REMOTE_EXECUTOR_WORKERS"`
+	// Remote executor checks health of workers every other interval.
+	REMOTE_EXECUTOR_HEALTH_CHECK_INTERVAL_SECOND int64 `yaml:"REMOTE_EXECUTOR_HEALTH_CHECK_INTERVAL_SECOND"`
+	// Timeout of a job executed on a remote worker.
+	REMOTE_EXECUTOR_REQUEST_TIMEOUT_SECOND int64 `yaml:"REMOTE_EXECUTOR_REQUEST_TIMEOUT_SECOND"`
+	// Politeness budgets of target domains, shared by all configs with the
+	// same domain. Budgets are per instance, jobs out of budget are deferred
+	// until the domain has budget again.
+	DOMAIN_BUDGETS []DomainBudgetSetting `yaml:"DOMAIN_BUDGETS"`
+	// Event bus shared by modules, "gochannel" (default, in-memory) or
+	// "postgres" (persisted, pending jobs survive restarts).
+	EVENT_BUS string `yaml:"EVENT_BUS"`
+	// Number of pending job partitions, which is also the max number of jobs
+	// executing at the same time. Defaults to 32.
+	PENDING_JOB_PARTITIONS int `yaml:"PENDING_JOB_PARTITIONS"`
+	// Address of the status http server, e.g. ":8090". Disabled if empty.
+	STATUS_SERVER_ADDR string `yaml:"STATUS_SERVER_ADDR"`
+	// Address of the admin http server, e.g. ":8091". Disabled if empty, the
+	// token is read from env PANOPTIC_ADMIN_TOKEN.
+	ADMIN_SERVER_ADDR string `yaml:"ADMIN_SERVER_ADDR"`
+	// Elect a leader among panoptic instances sharing the DB, only the leader
+	// schedules jobs. Followers take over after the leader's lease expires,
+	// which lasts 15 seconds by default.
+	LEADER_ELECTION              bool  `yaml:"LEADER_ELECTION"`
+	LEADER_LEASE_DURATION_SECOND int64 `yaml:"LEADER_LEASE_DURATION_SECOND"`
+	// Silence detector compares task runs within the window against history
+	// every check interval. Defaults to 3 hours and 10 minutes.
+	SILENCE_DETECTOR_WINDOW_SECOND         int64 `yaml:"SILENCE_DETECTOR_WINDOW_SECOND"`
+	SILENCE_DETECTOR_CHECK_INTERVAL_SECOND int64 `yaml:"SILENCE_DETECTOR_CHECK_INTERVAL_SECOND"`
+	// Task history older than this is pruned, defaults to 30 days. Must cover
+	// the silence detector's 14 days lookback.
+	TASK_RUN_RETENTION_DAYS int `yaml:"TASK_RUN_RETENTION_DAYS"`
+}
+
+// Budget of a target domain, zero means unlimited.
+type DomainBudgetSetting struct {
+	DOMAIN              string `yaml:"DOMAIN"`
+	REQUESTS_PER_MINUTE int    `yaml:"REQUESTS_PER_MINUTE"`
+	MAX_CONCURRENCY     int    `yaml:"MAX_CONCURRENCY"`
+}
+
+// A single source of Panoptic config.
+type ConfigSourceSetting struct {
+	// One of file, dir, github, db, http.
+	TYPE string `yaml:"TYPE"`
+	// Path of textproto file for file, directory of textproto fragments for
+	// dir, path in repository for github.
+	PATH string `yaml:"PATH"`
+	// Github repository owner and name, access token is read from env
+	// GITHUB_ACCESS_TOKEN.
+	OWNER string `yaml:"OWNER"`
+	REPO  string `yaml:"REPO"`
+	// Url serving textproto for http.
+	URL string `yaml:"URL"`
 }
 
 func ParsePanopticAppSetting(path string) PanopticAppSetting {
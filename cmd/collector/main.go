@@ -1,9 +1,17 @@
 package main
 
 import (
+	"crypto/subtle"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+
 	ddlambda "github.com/DataDog/datadog-lambda-go"
 	collector_hander "github.com/Luismorlan/newsmux/collector/handler"
 	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/panoptic"
 	"github.com/Luismorlan/newsmux/protocol"
 	"github.com/Luismorlan/newsmux/utils/dotenv"
 	. "github.com/Luismorlan/newsmux/utils/flag"
@@ -12,7 +20,27 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
+const (
+	// Serve as AWS Lambda function.
+	ModeLambda = "lambda"
+	// Read a serialized PanopticJob from stdin, execute it and write the
+	// serialized result to stdout. Used by panoptic LocalExecutor to run
+	// collector in a subprocess.
+	ModeStdin = "stdin"
+	// Serve as a long-lived worker, executing jobs posted to /collect. Used by
+	// panoptic RemoteHttpExecutor.
+	ModeHttp = "http"
+)
+
+var (
+	Mode *string
+	// Listen address in http mode.
+	Addr *string
+)
+
 func init() {
+	Mode = flag.String("mode", ModeLambda, "'lambda', 'stdin' or 'http'")
+	Addr = flag.String("addr", ":8080", "listen address in http mode")
 	Log.Info("data collector initialized")
 }
 
@@ -20,14 +48,14 @@ func cleanup() {
 	Log.Info("data collector shutdown")
 }
 
-func HandleRequest(event model.DataCollectorRequest) (model.DataCollectorResponse, error) {
-	res := model.DataCollectorResponse{}
-
+// Execute a serialized PanopticJob, returns the serialized job with
+// execution result.
+func CollectSerializedJob(serializedJob []byte) ([]byte, error) {
 	// parse job
 	job := &protocol.PanopticJob{}
-	if err := proto.Unmarshal(event.SerializedJob, job); err != nil {
+	if err := proto.Unmarshal(serializedJob, job); err != nil {
 		Log.Error("Failed to parse job with error:", err)
-		return res, err
+		return nil, err
 	}
 
 	// handle
@@ -35,10 +63,16 @@ func HandleRequest(event model.DataCollectorRequest) (model.DataCollectorRespons
 	err := handler.Collect(job)
 	if err != nil {
 		Log.Error("Failed to execute job with error:", err)
-		return res, err
+		return nil, err
 	}
 	// encode job
-	bytes, err := proto.Marshal(job)
+	return proto.Marshal(job)
+}
+
+func HandleRequest(event model.DataCollectorRequest) (model.DataCollectorResponse, error) {
+	res := model.DataCollectorResponse{}
+
+	bytes, err := CollectSerializedJob(event.SerializedJob)
 	if err != nil {
 		return res, err
 	}
@@ -47,6 +81,64 @@ func HandleRequest(event model.DataCollectorRequest) (model.DataCollectorRespons
 	return res, nil
 }
 
+func HandleStdin() error {
+	in, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+	out, err := CollectSerializedJob(in)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(out)
+	return err
+}
+
+// Handle a job posted by RemoteHttpExecutor, request and response bodies are
+// serialized PanopticJob. Only requests carrying the shared token are served.
+func HandleCollect(w http.ResponseWriter, r *http.Request, token string) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+token)) != 1 {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	in, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	out, err := CollectSerializedJob(in)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Write(out)
+}
+
+// Workers run on public IPs, without a token anyone could use them to crawl,
+// so the worker refuses to start without one.
+func ServeHttp(addr string) error {
+	token := os.Getenv(panoptic.RemoteCollectorTokenEnv)
+	if token == "" {
+		return fmt.Errorf("http worker requires %s", panoptic.RemoteCollectorTokenEnv)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(panoptic.RemoteCollectorHealthPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	})
+	mux.HandleFunc(panoptic.RemoteCollectorCollectPath, func(w http.ResponseWriter, r *http.Request) {
+		HandleCollect(w, r, token)
+	})
+	Log.Info("Starting http worker, listening on ", addr)
+	return http.ListenAndServe(addr, mux)
+}
+
 func main() {
 	ParseFlags()
 	InitLogger()
@@ -55,7 +147,22 @@ func main() {
 	if err := dotenv.LoadDotEnvs(); err != nil {
 		panic(err)
 	}
-	Log.Info("Starting lambda handler, waiting for requests...")
 
-	lambda.Start(ddlambda.WrapFunction(HandleRequest, nil))
+	switch *Mode {
+	case ModeStdin:
+		if err := HandleStdin(); err != nil {
+			Log.Error("Failed to handle job from stdin:", err)
+			cleanup()
+			os.Exit(1)
+		}
+	case ModeHttp:
+		if err := ServeHttp(*Addr); err != nil {
+			Log.Error("Http worker stopped with error:", err)
+			cleanup()
+			os.Exit(1)
+		}
+	default:
+		Log.Info("Starting lambda handler, waiting for requests...")
+		lambda.Start(ddlambda.WrapFunction(HandleRequest, nil))
+	}
 }
@@ -7,16 +7,17 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/DataDog/datadog-go/statsd"
 	"github.com/Luismorlan/newsmux/app_setting"
 	"github.com/Luismorlan/newsmux/panoptic"
 	"github.com/Luismorlan/newsmux/panoptic/modules"
+	"github.com/Luismorlan/newsmux/utils"
 	"github.com/Luismorlan/newsmux/utils/dotenv"
 	. "github.com/Luismorlan/newsmux/utils/flag"
 	. "github.com/Luismorlan/newsmux/utils/log"
 	"github.com/ThreeDotsLabs/watermill"
-	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 )
@@ -50,6 +51,10 @@ func CreateAndInitLambdaExecutor(ctx context.Context) *modules.LambdaExecutor {
 		LambdaPoolSize:       AppSetting.LAMBDA_POOL_SIZE,
 		LambdaLifeSpanSecond: AppSetting.LAMBDA_LIFE_SPAN_SECOND,
 		MaintainEverySecond:  AppSetting.MAINTAIN_EVERY_SECOND,
+
+		MaxAttempts:                 AppSetting.LAMBDA_MAX_ATTEMPTS,
+		RetryBackoffMillisecond:     AppSetting.LAMBDA_RETRY_BACKOFF_MILLISECOND,
+		RetryBudgetPerConfigPerHour: AppSetting.LAMBDA_RETRY_BUDGET_PER_CONFIG_PER_HOUR,
 	})
 	if err := executor.Init(); err != nil {
 		panic(err)
@@ -57,6 +62,86 @@ func CreateAndInitLambdaExecutor(ctx context.Context) *modules.LambdaExecutor {
 	return executor
 }
 
+// Wrap the executor with domain budgets if there's any.
+func CreateBudgetedExecutor(ctx context.Context) modules.Executor {
+	executor := CreateExecutor(ctx)
+	if len(AppSetting.DOMAIN_BUDGETS) == 0 {
+		return executor
+	}
+	budgets := []modules.DomainBudget{}
+	for _, b := range AppSetting.DOMAIN_BUDGETS {
+		budgets = append(budgets, modules.DomainBudget{
+			Domain:            b.DOMAIN,
+			RequestsPerMinute: b.REQUESTS_PER_MINUTE,
+			MaxConcurrency:    b.MAX_CONCURRENCY,
+		})
+	}
+	return modules.NewBudgetedExecutor(executor, budgets)
+}
+
+func CreateExecutor(ctx context.Context) modules.Executor {
+	switch AppSetting.EXECUTOR {
+	case "", "lambda":
+		return CreateAndInitLambdaExecutor(ctx)
+	case "local":
+		return modules.NewLocalExecutor(&modules.LocalExecutorConfig{
+			WorkerPoolSize:    AppSetting.LOCAL_EXECUTOR_POOL_SIZE,
+			JobTimeout:        time.Duration(AppSetting.LOCAL_EXECUTOR_JOB_TIMEOUT_SECOND) * time.Second,
+			SubprocessCommand: AppSetting.LOCAL_EXECUTOR_SUBPROCESS_COMMAND,
+		})
+	case "remote":
+		executor := modules.NewRemoteHttpExecutor(ctx, &modules.RemoteHttpExecutorConfig{
+			Workers:             AppSetting.REMOTE_EXECUTOR_WORKERS,
+			HealthCheckInterval: time.Duration(AppSetting.REMOTE_EXECUTOR_HEALTH_CHECK_INTERVAL_SECOND) * time.Second,
+			RequestTimeout:      time.Duration(AppSetting.REMOTE_EXECUTOR_REQUEST_TIMEOUT_SECOND) * time.Second,
+		})
+		if err := executor.Init(); err != nil {
+			panic(err)
+		}
+		return executor
+	default:
+		log.Fatalln("unknown executor:", AppSetting.EXECUTOR)
+		return nil
+	}
+}
+
+func CreateEventBus() panoptic.EventBus {
+	logger := watermill.NewStdLogger(false, false)
+	switch AppSetting.EVENT_BUS {
+	case "", "gochannel":
+		return panoptic.NewGoChannelEventBus(logger)
+	case "postgres":
+		db, err := utils.GetDBConnection()
+		if err != nil {
+			panic(err)
+		}
+		sqlDB, err := db.DB()
+		if err != nil {
+			panic(err)
+		}
+		eventbus, err := panoptic.NewSQLEventBus(sqlDB, logger)
+		if err != nil {
+			panic(err)
+		}
+		return eventbus
+	default:
+		log.Fatalln("unknown event bus:", AppSetting.EVENT_BUS)
+		return nil
+	}
+}
+
+// Alerts are always logged, and sent to webhooks configured by env.
+func CreateAlerters() []modules.Alerter {
+	alerters := []modules.Alerter{&modules.LoggingAlerter{}}
+	if url := os.Getenv(panoptic.AlertSlackWebhookUrlEnv); url != "" {
+		alerters = append(alerters, modules.NewSlackWebhookAlerter(url))
+	}
+	if url := os.Getenv(panoptic.AlertWebhookUrlEnv); url != "" {
+		alerters = append(alerters, modules.NewWebhookAlerter(url))
+	}
+	return alerters
+}
+
 func NewDogStatsdClient() *statsd.Client {
 	statsd, err := statsd.New("127.0.0.1:8125")
 	if err != nil {
@@ -71,41 +156,114 @@ func main() {
 
 	AppSetting = app_setting.ParsePanopticAppSetting(*AppSettingPath)
 
-	eventbus := gochannel.NewGoChannel(
-		gochannel.Config{
-			OutputChannelBuffer:            100,
-			BlockPublishUntilSubscriberAck: false,
-		},
-		watermill.NewStdLogger(false, false),
-	)
+	eventbus := CreateEventBus()
+	partitions := AppSetting.PENDING_JOB_PARTITIONS
+	if partitions <= 0 {
+		partitions = panoptic.DefaultPendingJobPartitions
+	}
 
 	rootCtx := context.Background()
 	ctx, cancel := context.WithCancel(rootCtx)
 
+	scheduler := modules.NewScheduler(
+		&AppSetting,
+		modules.SchedulerConfig{Name: "scheduler"},
+		eventbus,
+		modules.NewSchedulerJobDoer(eventbus, partitions),
+		ctx,
+	)
+
 	// Initialize all engine modules here.
-	modules := []panoptic.Module{
-		// Reporter reports the execution metrics to datadog for monitoring purpose.
-		modules.NewReporter(modules.ReporterConfig{Name: "reporter"}, NewDogStatsdClient(), eventbus),
+	ms := []panoptic.Module{
+		// Reporter reports the execution metrics to datadog for monitoring purpose,
+		// and persists task history into DB.
+		modules.NewReporter(modules.ReporterConfig{
+			Name:             "reporter",
+			TaskRunRetention: time.Duration(AppSetting.TASK_RUN_RETENTION_DAYS) * 24 * time.Hour,
+		}, NewDogStatsdClient(), scheduler.DB, eventbus),
 		// Scheduler parses data collector configs, fanout into multiple tasks and
 		// pushes onto EventBus.
-		modules.NewScheduler(
-			&AppSetting,
-			modules.SchedulerConfig{Name: "scheduler"},
+		scheduler,
+		// AlertDispatcher sends alerts emitted by other modules, e.g. a config is
+		// paused after consecutive failures.
+		modules.NewAlertDispatcher(
+			modules.AlertDispatcherConfig{Name: "alert_dispatcher"},
 			eventbus,
-			modules.NewSchedulerJobDoer(eventbus),
-			ctx,
+			CreateAlerters()...,
 		),
 		// Orchestrator listens tasks on EventBus, maintains an active Lambda pool
 		// and wrap Lambda result in a tasks and publish to the exporter for
 		// monitoring.
 		modules.NewOrchestrator(
-			modules.OrchestratorConfig{Name: "orchestrator"},
-			CreateAndInitLambdaExecutor(ctx),
+			modules.OrchestratorConfig{Name: "orchestrator", Partitions: partitions},
+			CreateBudgetedExecutor(ctx),
 			eventbus,
 		),
 	}
 
-	engine := panoptic.NewEngine(modules, ctx, cancel, eventbus)
+	// With leader election, multiple instances can run without scheduling
+	// every job more than once.
+	if AppSetting.LEADER_ELECTION {
+		if scheduler.DB == nil {
+			log.Fatalln("leader election requires a database connection")
+		}
+		elector, err := modules.NewLeaderElector(
+			modules.LeaderElectorConfig{
+				Name:          "leader_elector",
+				LeaseDuration: time.Duration(AppSetting.LEADER_LEASE_DURATION_SECOND) * time.Second,
+			},
+			scheduler.DB,
+		)
+		if err != nil {
+			log.Fatalln("fail to create leader elector:", err)
+		}
+		scheduler.Elector = elector
+		ms = append(ms, elector)
+	}
+
+	// Silence detector alerts configs going quiet, learned from task history
+	// persisted by reporter. Only the leader checks if there're multiple
+	// instances.
+	if scheduler.DB != nil {
+		detector := modules.NewSilenceDetector(
+			modules.SilenceDetectorConfig{
+				Name:          "silence_detector",
+				Window:        time.Duration(AppSetting.SILENCE_DETECTOR_WINDOW_SECOND) * time.Second,
+				CheckInterval: time.Duration(AppSetting.SILENCE_DETECTOR_CHECK_INTERVAL_SECOND) * time.Second,
+			},
+			scheduler.DB,
+			eventbus,
+		)
+		detector.Elector = scheduler.Elector
+		ms = append(ms, detector)
+	}
+
+	// Status server exposes module status such as config loading errors.
+	if AppSetting.STATUS_SERVER_ADDR != "" {
+		ms = append(ms, modules.NewStatusServer(
+			modules.StatusServerConfig{Name: "status_server", Addr: AppSetting.STATUS_SERVER_ADDR},
+			scheduler,
+			scheduler.DB,
+		))
+	}
+
+	// Admin server lets operators trigger, pause and resume jobs at runtime.
+	if AppSetting.ADMIN_SERVER_ADDR != "" {
+		adminServer, err := modules.NewAdminServer(
+			modules.AdminServerConfig{
+				Name:  "admin_server",
+				Addr:  AppSetting.ADMIN_SERVER_ADDR,
+				Token: os.Getenv(panoptic.AdminTokenEnv),
+			},
+			scheduler,
+		)
+		if err != nil {
+			log.Fatalln("fail to create admin server:", err)
+		}
+		ms = append(ms, adminServer)
+	}
+
+	engine := panoptic.NewEngine(ms, ctx, cancel, eventbus)
 
 	go engine.Run()
 
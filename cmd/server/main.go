@@ -4,6 +4,7 @@ import (
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/Luismorlan/newsmux/server"
 	"github.com/Luismorlan/newsmux/server/middlewares"
+	"github.com/Luismorlan/newsmux/utils"
 	"github.com/Luismorlan/newsmux/utils/dotenv"
 	. "github.com/Luismorlan/newsmux/utils/flag"
 	. "github.com/Luismorlan/newsmux/utils/log"
@@ -35,12 +36,31 @@ func main() {
 
 	// Default With the Logger and Recovery middleware already attached
 	router := gin.Default()
+	// Clients talk to the server directly, don't trust X-Forwarded-For from
+	// them in ClientIP.
+	router.TrustedProxies = nil
 
 	router.Use(cors.Default())
 	router.Use(gintrace.Middleware(*ServiceName))
+
+	// Syndicated feeds are read by RSS readers which can't do Cognito login,
+	// they are protected by per-feed token instead. Must be registered before
+	// JWT middleware so that it's not applied to these routes.
+	router.GET("/feeds/:id/:format", server.FeedSyndicationHandler())
+
+	// Rate limits are shared across replicas through Redis.
+	limiter, err := utils.GetRedisStatusStore()
+	if err != nil {
+		panic(err)
+	}
+	router.Use(middlewares.RateLimitByIP(limiter, middlewares.DefaultIPRateLimit))
+
 	if !*ByPassAuth {
 		router.Use(middlewares.JWT())
+	} else {
+		router.Use(middlewares.BypassAuth())
 	}
+	router.Use(middlewares.RateLimitByUser(limiter, middlewares.DefaultUserRateLimit))
 
 	handler := server.GraphqlHandler()
 	router.POST("/api/graphql", handler)
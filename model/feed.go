@@ -27,6 +27,8 @@ Posts: all posts published to this feed, "many-to-many" relation
 
 SubSources: All subsources this feed is listening to, "many-to-many" relationship.
 	Do not only rely on subsource to infer source, so that we can have Feed only subscribe to source
+ClonedFromFeedID: the feed this feed was cloned from, nil if it's not a clone or the original is deleted
+SyndicationToken: unguessable token required to read a PRIVATE feed as RSS/Atom/JSON Feed, nil if never issued
 */
 type Feed struct {
 	Id                   string    `gorm:"primaryKey"`
@@ -41,6 +43,9 @@ type Feed struct {
 	SubSources           []*SubSource `json:"subSources" gorm:"many2many:feed_subsources;constraint:OnDelete:CASCADE;"`
 	Visibility           Visibility   `json:"visibility" gorm:"default:'PRIVATE';"`
 	FilterDataExpression datatypes.JSON
+	ClonedFromFeedID     *string `gorm:"index"`
+	ClonedFromFeed       *Feed   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	SyndicationToken     *string `gorm:"uniqueIndex"`
 }
 
 func (Feed) IsFeedSeedStateInterface() {}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+)
+
+/*
+
+FeedCollaborator is a relation granting a user a role on a feed, so that a
+feed can be curated by a team instead of only its creator. Feed creator is
+implicitly the owner and doesn't need a FeedCollaborator row.
+
+FeedID: feed id
+UserID: collaborator's user id
+Role: VIEWER can read a PRIVATE feed, EDITOR can also update feed setting,
+	OWNER can also delete the feed and manage collaborators
+CreatedAt: time when relation is created
+
+*/
+
+type FeedCollaborator struct {
+	FeedID    string               `gorm:"primaryKey"`
+	Feed      Feed                 `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	UserID    string               `gorm:"primaryKey"`
+	User      User                 `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Role      FeedCollaboratorRole `gorm:"default:'VIEWER';"`
+	CreatedAt time.Time
+}
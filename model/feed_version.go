@@ -0,0 +1,43 @@
+package model
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"gorm.io/datatypes"
+)
+
+/*
+
+FeedVersion is a snapshot of a feed's setting taken on every change, so that
+a bad edit can be reviewed and undone.
+
+Id: primary key, use to identify a version
+CreatedAt: time when the change is made
+FeedID: feed the snapshot belongs to
+Version: increasing from 1 within a feed, 0 is the snapshot of a feed created
+  before versioning, taken on migration
+AuthorID: user who made the change, null if the user is deleted
+Action: CREATE, UPDATE or RESTORE
+RestoredFromVersion: the version restored from if Action is RESTORE
+Name, FilterDataExpression, Visibility, SubSourceIds: feed setting after the change
+Diff: changes comparing to the previous version, computed on read
+
+*/
+
+type FeedVersion struct {
+	Id                   string    `gorm:"primaryKey"`
+	CreatedAt            time.Time `gorm:"<-:create"`
+	FeedID               string    `gorm:"uniqueIndex:idx_feed_version;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Feed                 Feed      `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Version              int       `gorm:"uniqueIndex:idx_feed_version"`
+	AuthorID             *string   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Author               *User     `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Action               FeedVersionAction
+	RestoredFromVersion  *int
+	Name                 string
+	FilterDataExpression datatypes.JSON
+	Visibility           Visibility
+	SubSourceIds         pq.StringArray   `gorm:"type:TEXT[]"`
+	Diff                 *FeedVersionDiff `gorm:"-"`
+}
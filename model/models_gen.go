@@ -17,6 +17,13 @@ type UserSeedStateInterface interface {
 	IsUserSeedStateInterface()
 }
 
+type AddFeedCollaboratorInput struct {
+	UserID         string               `json:"userId"`
+	FeedID         string               `json:"feedId"`
+	CollaboratorID string               `json:"collaboratorId"`
+	Role           FeedCollaboratorRole `json:"role"`
+}
+
 type AddSubSourceInput struct {
 	SourceID          string `json:"sourceId"`
 	SubSourceUserName string `json:"subSourceUserName"`
@@ -26,6 +33,25 @@ type AddWeiboSubSourceInput struct {
 	Name string `json:"name"`
 }
 
+type CloneFeedInput struct {
+	FeedID    string `json:"feedId"`
+	UserID    string `json:"userId"`
+	Name      string `json:"name"`
+	CopyPosts *bool  `json:"copyPosts"`
+}
+
+type CreatePersonalAccessTokenInput struct {
+	UserID    string                     `json:"userId"`
+	Name      string                     `json:"name"`
+	Scopes    []PersonalAccessTokenScope `json:"scopes"`
+	ExpiresAt *time.Time                 `json:"expiresAt"`
+}
+
+type CreatePersonalAccessTokenResult struct {
+	Token               string               `json:"token"`
+	PersonalAccessToken *PersonalAccessToken `json:"personalAccessToken"`
+}
+
 type CustomizedCrawlerPanopticConfigForm struct {
 	Name                      *string                  `json:"name"`
 	StartImmediately          *bool                    `json:"startImmediately"`
@@ -66,6 +92,17 @@ type DeleteSubSourceInput struct {
 	SubsourceID string `json:"subsourceId"`
 }
 
+type ExportFeedsInput struct {
+	UserID string           `json:"userId"`
+	Format FeedExportFormat `json:"format"`
+}
+
+type FeedHistoryInput struct {
+	UserID string `json:"userId"`
+	FeedID string `json:"feedId"`
+	Limit  *int   `json:"limit"`
+}
+
 type FeedRefreshInput struct {
 	FeedID          string               `json:"feedId"`
 	Limit           int                  `json:"limit"`
@@ -86,11 +123,92 @@ type FeedSeedStateInput struct {
 	Name string `json:"name"`
 }
 
+type FeedStats struct {
+	FeedID         string                `json:"feedId"`
+	From           time.Time             `json:"from"`
+	To             time.Time             `json:"to"`
+	Bucket         FeedStatsBucketSize   `json:"bucket"`
+	Volume         []*FeedStatsBucket    `json:"volume"`
+	SubSources     []*FeedStatsSubSource `json:"subSources"`
+	PublishedCount int                   `json:"publishedCount"`
+	CandidateCount int                   `json:"candidateCount"`
+	MatchRate      float64               `json:"matchRate"`
+	TopTags        []*FeedStatsTag       `json:"topTags"`
+}
+
+type FeedStatsBucket struct {
+	Start time.Time `json:"start"`
+	Count int       `json:"count"`
+}
+
+type FeedStatsInput struct {
+	UserID string              `json:"userId"`
+	FeedID string              `json:"feedId"`
+	From   time.Time           `json:"from"`
+	To     time.Time           `json:"to"`
+	Bucket FeedStatsBucketSize `json:"bucket"`
+}
+
+type FeedStatsSubSource struct {
+	SubSourceID string `json:"subSourceId"`
+	Name        string `json:"name"`
+	Count       int    `json:"count"`
+}
+
+type FeedStatsTag struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+type FeedUnreadCount struct {
+	FeedID    string `json:"feedId"`
+	Count     int    `json:"count"`
+	Truncated bool   `json:"truncated"`
+}
+
+type FeedVersionDiff struct {
+	NameChanged                 bool     `json:"nameChanged"`
+	FilterDataExpressionChanged bool     `json:"filterDataExpressionChanged"`
+	VisibilityChanged           bool     `json:"visibilityChanged"`
+	AddedSubSourceIds           []string `json:"addedSubSourceIds"`
+	RemovedSubSourceIds         []string `json:"removedSubSourceIds"`
+}
+
 type FeedsGetPostsInput struct {
 	UserID            string              `json:"userId"`
 	FeedRefreshInputs []*FeedRefreshInput `json:"feedRefreshInputs"`
 }
 
+type ImportFeedsInput struct {
+	UserID string           `json:"userId"`
+	Format FeedExportFormat `json:"format"`
+	Data   string           `json:"data"`
+}
+
+type ImportFeedsIssue struct {
+	FeedName string `json:"feedName"`
+	Message  string `json:"message"`
+}
+
+type ImportFeedsResult struct {
+	ImportedFeeds  []*Feed             `json:"importedFeeds"`
+	Conflicts      []*ImportFeedsIssue `json:"conflicts"`
+	UnknownSources []*ImportFeedsIssue `json:"unknownSources"`
+	Failures       []*ImportFeedsIssue `json:"failures"`
+}
+
+type MarkFeedReadInput struct {
+	UserID     string `json:"userId"`
+	FeedID     string `json:"feedId"`
+	UpToCursor int    `json:"upToCursor"`
+}
+
+type MuteInput struct {
+	UserID string   `json:"userId"`
+	Type   MuteType `json:"type"`
+	Target string   `json:"target"`
+}
+
 type NewPostInput struct {
 	Title            string   `json:"title"`
 	Content          string   `json:"content"`
@@ -111,6 +229,10 @@ type NewUserInput struct {
 	Name string `json:"name"`
 }
 
+type PersonalAccessTokensInput struct {
+	UserID string `json:"userId"`
+}
+
 type PostInFeedOutput struct {
 	Post   *Post `json:"post"`
 	Cursor int   `json:"cursor"`
@@ -120,6 +242,28 @@ type PostInput struct {
 	ID string `json:"id"`
 }
 
+type RemoveFeedCollaboratorInput struct {
+	UserID         string `json:"userId"`
+	FeedID         string `json:"feedId"`
+	CollaboratorID string `json:"collaboratorId"`
+}
+
+type RestoreFeedVersionInput struct {
+	UserID  string `json:"userId"`
+	FeedID  string `json:"feedId"`
+	Version int    `json:"version"`
+}
+
+type RevokePersonalAccessTokenInput struct {
+	UserID  string `json:"userId"`
+	TokenID string `json:"tokenId"`
+}
+
+type RotateFeedSyndicationTokenInput struct {
+	UserID string `json:"userId"`
+	FeedID string `json:"feedId"`
+}
+
 type SeedStateInput struct {
 	UserSeedState *UserSeedStateInput   `json:"userSeedState"`
 	FeedSeedState []*FeedSeedStateInput `json:"feedSeedState"`
@@ -146,6 +290,11 @@ type SubsourcesInput struct {
 	IsCustomized     *bool `json:"isCustomized"`
 }
 
+type UnmuteInput struct {
+	UserID string `json:"userId"`
+	MuteID string `json:"muteId"`
+}
+
 type UpsertFeedInput struct {
 	UserID               string     `json:"userId"`
 	FeedID               *string    `json:"feedId"`
@@ -179,6 +328,90 @@ type UserSeedStateInput struct {
 	AvatarURL string `json:"avatarUrl"`
 }
 
+type FeedCollaboratorRole string
+
+const (
+	FeedCollaboratorRoleViewer FeedCollaboratorRole = "VIEWER"
+	FeedCollaboratorRoleEditor FeedCollaboratorRole = "EDITOR"
+	FeedCollaboratorRoleOwner  FeedCollaboratorRole = "OWNER"
+)
+
+var AllFeedCollaboratorRole = []FeedCollaboratorRole{
+	FeedCollaboratorRoleViewer,
+	FeedCollaboratorRoleEditor,
+	FeedCollaboratorRoleOwner,
+}
+
+func (e FeedCollaboratorRole) IsValid() bool {
+	switch e {
+	case FeedCollaboratorRoleViewer, FeedCollaboratorRoleEditor, FeedCollaboratorRoleOwner:
+		return true
+	}
+	return false
+}
+
+func (e FeedCollaboratorRole) String() string {
+	return string(e)
+}
+
+func (e *FeedCollaboratorRole) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FeedCollaboratorRole(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FeedCollaboratorRole", str)
+	}
+	return nil
+}
+
+func (e FeedCollaboratorRole) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FeedExportFormat string
+
+const (
+	FeedExportFormatOpml FeedExportFormat = "OPML"
+	FeedExportFormatJSON FeedExportFormat = "JSON"
+)
+
+var AllFeedExportFormat = []FeedExportFormat{
+	FeedExportFormatOpml,
+	FeedExportFormatJSON,
+}
+
+func (e FeedExportFormat) IsValid() bool {
+	switch e {
+	case FeedExportFormatOpml, FeedExportFormatJSON:
+		return true
+	}
+	return false
+}
+
+func (e FeedExportFormat) String() string {
+	return string(e)
+}
+
+func (e *FeedExportFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FeedExportFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FeedExportFormat", str)
+	}
+	return nil
+}
+
+func (e FeedExportFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type FeedRefreshDirection string
 
 const (
@@ -220,6 +453,92 @@ func (e FeedRefreshDirection) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type FeedStatsBucketSize string
+
+const (
+	FeedStatsBucketSizeHour FeedStatsBucketSize = "HOUR"
+	FeedStatsBucketSizeDay  FeedStatsBucketSize = "DAY"
+	FeedStatsBucketSizeWeek FeedStatsBucketSize = "WEEK"
+)
+
+var AllFeedStatsBucketSize = []FeedStatsBucketSize{
+	FeedStatsBucketSizeHour,
+	FeedStatsBucketSizeDay,
+	FeedStatsBucketSizeWeek,
+}
+
+func (e FeedStatsBucketSize) IsValid() bool {
+	switch e {
+	case FeedStatsBucketSizeHour, FeedStatsBucketSizeDay, FeedStatsBucketSizeWeek:
+		return true
+	}
+	return false
+}
+
+func (e FeedStatsBucketSize) String() string {
+	return string(e)
+}
+
+func (e *FeedStatsBucketSize) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FeedStatsBucketSize(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FeedStatsBucketSize", str)
+	}
+	return nil
+}
+
+func (e FeedStatsBucketSize) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FeedVersionAction string
+
+const (
+	FeedVersionActionCreate  FeedVersionAction = "CREATE"
+	FeedVersionActionUpdate  FeedVersionAction = "UPDATE"
+	FeedVersionActionRestore FeedVersionAction = "RESTORE"
+)
+
+var AllFeedVersionAction = []FeedVersionAction{
+	FeedVersionActionCreate,
+	FeedVersionActionUpdate,
+	FeedVersionActionRestore,
+}
+
+func (e FeedVersionAction) IsValid() bool {
+	switch e {
+	case FeedVersionActionCreate, FeedVersionActionUpdate, FeedVersionActionRestore:
+		return true
+	}
+	return false
+}
+
+func (e FeedVersionAction) String() string {
+	return string(e)
+}
+
+func (e *FeedVersionAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FeedVersionAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FeedVersionAction", str)
+	}
+	return nil
+}
+
+func (e FeedVersionAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ItemType string
 
 const (
@@ -261,6 +580,90 @@ func (e ItemType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type MuteType string
+
+const (
+	MuteTypeSubsource MuteType = "SUBSOURCE"
+	MuteTypeKeyword   MuteType = "KEYWORD"
+	MuteTypePost      MuteType = "POST"
+)
+
+var AllMuteType = []MuteType{
+	MuteTypeSubsource,
+	MuteTypeKeyword,
+	MuteTypePost,
+}
+
+func (e MuteType) IsValid() bool {
+	switch e {
+	case MuteTypeSubsource, MuteTypeKeyword, MuteTypePost:
+		return true
+	}
+	return false
+}
+
+func (e MuteType) String() string {
+	return string(e)
+}
+
+func (e *MuteType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MuteType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MuteType", str)
+	}
+	return nil
+}
+
+func (e MuteType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PersonalAccessTokenScope string
+
+const (
+	PersonalAccessTokenScopeRead       PersonalAccessTokenScope = "READ"
+	PersonalAccessTokenScopeFeedsWrite PersonalAccessTokenScope = "FEEDS_WRITE"
+)
+
+var AllPersonalAccessTokenScope = []PersonalAccessTokenScope{
+	PersonalAccessTokenScopeRead,
+	PersonalAccessTokenScopeFeedsWrite,
+}
+
+func (e PersonalAccessTokenScope) IsValid() bool {
+	switch e {
+	case PersonalAccessTokenScopeRead, PersonalAccessTokenScopeFeedsWrite:
+		return true
+	}
+	return false
+}
+
+func (e PersonalAccessTokenScope) String() string {
+	return string(e)
+}
+
+func (e *PersonalAccessTokenScope) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PersonalAccessTokenScope(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PersonalAccessTokenScope", str)
+	}
+	return nil
+}
+
+func (e PersonalAccessTokenScope) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SignalType string
 
 const (
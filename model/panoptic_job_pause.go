@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+)
+
+/*
+
+PanopticJobPause is an admin pause of a panoptic config, so that the pause is
+kept across restarts and seen by whichever instance is the leader.
+
+ConfigName: name of the paused config
+Reason: why admin paused the config
+
+*/
+
+type PanopticJobPause struct {
+	ConfigName string `gorm:"primaryKey"`
+	Reason     string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"time"
+)
+
+/*
+
+PanopticLeaderLease is held by the panoptic instance acting as leader, so that
+jobs are only scheduled by one of multiple running instances.
+
+Name: what the lease is for, e.g. scheduler
+Holder: unique id of the instance holding the lease
+ExpiresAt: the lease can be taken over by other instances after this time,
+holder renews it periodically before it expires
+
+*/
+
+type PanopticLeaderLease struct {
+	Name      string `gorm:"primaryKey"`
+	Holder    string
+	ExpiresAt time.Time
+}
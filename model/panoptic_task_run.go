@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+)
+
+/*
+
+PanopticTaskRun is an executed PanopticTask, written by panoptic reporter so
+that crawler health can be inspected without Datadog.
+
+Id: task id
+CreatedAt: time when the run is recorded
+ConfigName: name of the PanopticConfig triggering the task
+JobId: job the task belongs to
+DataCollectorId: data collector executing the task
+IpAddr: where the task is executed
+ResultState: result state determined by data collector, e.g. STATE_SUCCESS
+TotalMessageCollected, TotalMessageFailed, TotalMessageSkipped: message counts
+StartTime, EndTime: execution span of the task
+
+*/
+
+type PanopticTaskRun struct {
+	Id                    string    `json:"id" gorm:"primaryKey"`
+	CreatedAt             time.Time `json:"createdAt" gorm:"<-:create"`
+	ConfigName            string    `json:"configName" gorm:"index:idx_panoptic_task_run_config_start"`
+	JobId                 string    `json:"jobId"`
+	DataCollectorId       string    `json:"dataCollectorId"`
+	IpAddr                string    `json:"ipAddr"`
+	ResultState           string    `json:"resultState"`
+	TotalMessageCollected int       `json:"totalMessageCollected"`
+	TotalMessageFailed    int       `json:"totalMessageFailed"`
+	TotalMessageSkipped   int       `json:"totalMessageSkipped"`
+	StartTime             time.Time `json:"startTime" gorm:"index:idx_panoptic_task_run_config_start;index"`
+	EndTime               time.Time `json:"endTime"`
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+)
+
+/*
+
+PersonalAccessToken lets scripts and bots call the API on behalf of a user
+without Cognito login. Only the hash of the token is stored, the token itself
+is returned once on creation.
+
+Id: primary key, use to identify a token
+CreatedAt: time when entity is created
+UserID: user the token acts as
+Name: user provided description
+TokenHash: sha256 of the token in hex
+Scopes: what the token is allowed to do, e.g. "read", "feeds:write"
+LastUsedAt: last time the token is verified, nil if never used
+ExpiresAt: token is rejected after this time, nil if never expires
+RevokedAt: token is rejected after revoked, nil if not revoked
+
+*/
+
+type PersonalAccessToken struct {
+	Id         string `gorm:"primaryKey"`
+	CreatedAt  time.Time
+	UserID     string `gorm:"index;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	User       User   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Name       string
+	TokenHash  string         `gorm:"uniqueIndex"`
+	Scopes     pq.StringArray `gorm:"type:TEXT[]"`
+	LastUsedAt *time.Time
+	ExpiresAt  *time.Time
+	RevokedAt  *time.Time
+}
@@ -3,4 +3,5 @@ package model
 type SeedState struct {
 	UserSeedState *UserSeedState   `json:"userSeedState"`
 	FeedSeedState []*FeedSeedState `json:"feedSeedState"`
+	Mutes         []*UserMute      `json:"mutes"`
 }
@@ -21,6 +21,7 @@ Creator: user who added this source, "belongs-to" relation, if user row is delet
 Name: the display name of the source for example "twitter"
 Domain: the domain of a source, for example "twitter.com"
 SubSources: sub sources in this source, for example followed twitter users are subsource of "twitter", "has-many" relation
+CustomizedCrawlerPanopticConfig: PanopticConfig in textproto for a user created source crawled by customized crawler, nil otherwise
 */
 
 type Source struct {
@@ -32,6 +33,8 @@ type Source struct {
 	Name       string
 	Domain     string
 	SubSources []SubSource `gorm:"constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+
+	CustomizedCrawlerPanopticConfig *string
 }
 
 func (Source) IsSourceSeedStateInterface() {}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+)
+
+/*
+
+UserMute hides posts from a user at read time, without changing what is
+published to feeds, so that a user can silence noise in feeds they can't edit.
+
+Id: primary key, use to identify a mute
+CreatedAt: time when entity is created
+UserID: user who mutes
+Type: what Target refers to
+Target: subsource id for SUBSOURCE, keyword for KEYWORD, post id for POST
+
+*/
+
+type UserMute struct {
+	Id        string `gorm:"primaryKey"`
+	CreatedAt time.Time
+	UserID    string   `gorm:"uniqueIndex:idx_user_mute_target;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	User      User     `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Type      MuteType `gorm:"uniqueIndex:idx_user_mute_target"`
+	Target    string   `gorm:"uniqueIndex:idx_user_mute_target"`
+}
@@ -2,6 +2,8 @@ package model
 
 type UserState struct {
 	User *User `json:"user"`
+	// Feeds the user is able to update, either as creator or collaborator.
+	EditableFeedIds []string `json:"editableFeedIds"`
 }
 
 type UserStateInput struct {
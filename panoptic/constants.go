@@ -4,6 +4,23 @@ const (
 	// Task emitted by executor and is in pending state.
 	TopicPendingJob  = "topic.pending_job"
 	TopicExecutedJob = "topic.executed_job"
+	// Alerts that need human attention, e.g. a config is paused.
+	TopicAlert = "topic.alert"
+
+	// Endpoints served by remote collector workers, see cmd/collector.
+	RemoteCollectorCollectPath = "/collect"
+	RemoteCollectorHealthPath  = "/healthz"
+	// Env of the shared secret between RemoteHttpExecutor and workers, sent as
+	// bearer token. Workers refuse to start without it.
+	RemoteCollectorTokenEnv = "REMOTE_COLLECTOR_TOKEN"
+
+	// Envs of webhooks alerts are sent to, alerts are only logged if not set.
+	AlertSlackWebhookUrlEnv = "ALERT_SLACK_WEBHOOK_URL"
+	AlertWebhookUrlEnv      = "ALERT_WEBHOOK_URL"
+
+	// Env of the shared secret of panoptic admin server, sent as bearer token.
+	// Admin server doesn't start if it's not set.
+	AdminTokenEnv = "PANOPTIC_ADMIN_TOKEN"
 
 	LambdaAwsRole      = "arn:aws:iam::213288384225:role/service-role/test_ddog_logging-role-8qnsddqu"
 	DataCollectorImage = "213288384225.dkr.ecr.us-west-1.amazonaws.com/data_collector:latest"
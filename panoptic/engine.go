@@ -5,7 +5,6 @@ import (
 	"sync"
 
 	Logger "github.com/Luismorlan/newsmux/utils/log"
-	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 )
 
 // Engine manages shared resources and execution lifecycle of each module. It
@@ -21,14 +20,13 @@ type Engine struct {
 	// Cancel function for root context, used for graceful shutdown
 	cancel context.CancelFunc
 
-	// The EventBus this engine managed. For now we use a golang channel
-	// implementation for the EventBus, but later when needed we could substitute
-	// it with Kafka-based EventBus.
-	EventBus *gochannel.GoChannel
+	// The EventBus this engine managed, either in-memory or persisted in
+	// Postgres.
+	EventBus EventBus
 }
 
 // Create a new Engine given the provided modules and event bus.
-func NewEngine(ms []Module, ctx context.Context, cancel context.CancelFunc, e *gochannel.GoChannel) *Engine {
+func NewEngine(ms []Module, ctx context.Context, cancel context.CancelFunc, e EventBus) *Engine {
 	return &Engine{
 		Modules:  ms,
 		ctx:      ctx,
@@ -72,4 +70,8 @@ func (e *Engine) Shutdown() {
 
 	// Block until all goroutine finished execution.
 	wg.Wait()
+
+	if err := e.EventBus.Close(); err != nil {
+		Logger.Log.Errorf("fail to close event bus: %v", err)
+	}
 }
@@ -0,0 +1,139 @@
+package panoptic
+
+import (
+	"context"
+	stdSQL "database/sql"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill-sql/pkg/sql"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+)
+
+// Default number of pending job topics.
+const DefaultPendingJobPartitions = 32
+
+// EventBus is the pub/sub shared by engine modules.
+//
+// Delivery is at least once: subscribers should Ack a message only after it's
+// processed, a message Nacked or not acked before a crash is delivered again.
+// Every consumer group receives all messages of a topic. Within a
+// subscription messages are delivered one by one, the next message is
+// delivered after the previous one is acked.
+type EventBus interface {
+	Publish(topic string, messages ...*message.Message) error
+
+	Subscribe(ctx context.Context, topic string, consumerGroup string) (<-chan *message.Message, error)
+
+	Close() error
+}
+
+// PendingJobTopic returns the topic of a pending job partition. Pending jobs
+// are spread over partitions so that orchestrator executes jobs of different
+// partitions concurrently, while acking each job after it's executed.
+func PendingJobTopic(partition int) string {
+	return fmt.Sprintf("%s.%d", TopicPendingJob, partition)
+}
+
+// PendingJobPartition returns the partition of a config, jobs of the same
+// config always go to the same partition.
+func PendingJobPartition(configName string, partitions int) int {
+	if partitions <= 1 {
+		return 0
+	}
+	h := fnv.New32a()
+	h.Write([]byte(configName))
+	return int(h.Sum32() % uint32(partitions))
+}
+
+// GoChannelEventBus is an in-memory EventBus, pending messages are lost on
+// restart. Consumer group is ignored since each subscription receives all
+// messages.
+type GoChannelEventBus struct {
+	*gochannel.GoChannel
+}
+
+func NewGoChannelEventBus(logger watermill.LoggerAdapter) *GoChannelEventBus {
+	return &GoChannelEventBus{
+		GoChannel: gochannel.NewGoChannel(
+			gochannel.Config{
+				OutputChannelBuffer:            100,
+				BlockPublishUntilSubscriberAck: false,
+			},
+			logger,
+		),
+	}
+}
+
+func (b *GoChannelEventBus) Subscribe(ctx context.Context, topic string, consumerGroup string) (<-chan *message.Message, error) {
+	return b.GoChannel.Subscribe(ctx, topic)
+}
+
+// SQLEventBus persists messages in Postgres, one table per topic, with
+// offsets tracked per consumer group. Messages survive restarts.
+type SQLEventBus struct {
+	db *stdSQL.DB
+
+	logger watermill.LoggerAdapter
+
+	publisher *sql.Publisher
+
+	m           sync.Mutex
+	subscribers []*sql.Subscriber
+}
+
+func NewSQLEventBus(db *stdSQL.DB, logger watermill.LoggerAdapter) (*SQLEventBus, error) {
+	publisher, err := sql.NewPublisher(db, sql.PublisherConfig{
+		SchemaAdapter:        sql.DefaultPostgreSQLSchema{},
+		AutoInitializeSchema: true,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &SQLEventBus{
+		db:        db,
+		logger:    logger,
+		publisher: publisher,
+	}, nil
+}
+
+func (b *SQLEventBus) Publish(topic string, messages ...*message.Message) error {
+	return b.publisher.Publish(topic, messages...)
+}
+
+func (b *SQLEventBus) Subscribe(ctx context.Context, topic string, consumerGroup string) (<-chan *message.Message, error) {
+	subscriber, err := sql.NewSubscriber(b.db, sql.SubscriberConfig{
+		ConsumerGroup:    consumerGroup,
+		SchemaAdapter:    sql.DefaultPostgreSQLSchema{},
+		OffsetsAdapter:   sql.DefaultPostgreSQLOffsetsAdapter{},
+		InitializeSchema: true,
+	}, b.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	b.m.Lock()
+	b.subscribers = append(b.subscribers, subscriber)
+	b.m.Unlock()
+
+	return subscriber.Subscribe(ctx, topic)
+}
+
+func (b *SQLEventBus) Close() error {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	var res error
+	for _, subscriber := range b.subscribers {
+		if err := subscriber.Close(); err != nil {
+			res = err
+		}
+	}
+	if err := b.publisher.Close(); err != nil {
+		res = err
+	}
+	return res
+}
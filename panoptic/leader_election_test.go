@@ -0,0 +1,120 @@
+package panoptic_test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Luismorlan/newsmux/app_setting"
+	"github.com/Luismorlan/newsmux/panoptic"
+	"github.com/Luismorlan/newsmux/panoptic/modules"
+	"github.com/Luismorlan/newsmux/utils"
+	"github.com/ThreeDotsLabs/watermill"
+)
+
+const leaderElectionTestConfig = `
+config {
+	name: "cfg_1"
+	data_collector_id: COLLECTOR_JINSHI
+	task_params: {
+		source_id: "dummy_source_id"
+	}
+	task_schedule: {
+		start_immediatly: true
+		routinely: {
+			every_milliseconds: 100
+		}
+	}
+}
+`
+
+type countingJobDoer struct {
+	count int64
+}
+
+func (d *countingJobDoer) Do(job *modules.SchedulerJob) error {
+	atomic.AddInt64(&d.count, 1)
+	return nil
+}
+
+func (d *countingJobDoer) Count() int64 {
+	return atomic.LoadInt64(&d.count)
+}
+
+type testInstance struct {
+	engine  *panoptic.Engine
+	cancel  context.CancelFunc
+	elector *modules.LeaderElector
+	doer    *countingJobDoer
+}
+
+func TestLeaderElection(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+
+	path := filepath.Join(t.TempDir(), "config.textproto")
+	require.Nil(t, ioutil.WriteFile(path, []byte(leaderElectionTestConfig), 0644))
+	setting := &app_setting.PanopticAppSetting{
+		SCHEDULER_CONFIG_POLL_INTERVAL_SECOND: 60,
+		CONFIG_SOURCES: []app_setting.ConfigSourceSetting{
+			{TYPE: modules.ConfigSourceTypeFile, PATH: path},
+		},
+	}
+
+	newInstance := func(holder string) *testInstance {
+		ctx, cancel := context.WithCancel(context.Background())
+		elector, err := modules.NewLeaderElector(modules.LeaderElectorConfig{
+			Name:          "leader_elector",
+			Holder:        holder,
+			LeaseDuration: 2 * time.Second,
+			RenewInterval: 200 * time.Millisecond,
+		}, db)
+		require.Nil(t, err)
+
+		eventbus := panoptic.NewGoChannelEventBus(watermill.NewStdLogger(false, false))
+		doer := &countingJobDoer{}
+		scheduler := modules.NewScheduler(setting, modules.SchedulerConfig{Name: "scheduler"}, eventbus, doer, ctx)
+		scheduler.DB = db
+		scheduler.Elector = elector
+
+		engine := panoptic.NewEngine([]panoptic.Module{elector, scheduler}, ctx, cancel, eventbus)
+		go engine.Run()
+		return &testInstance{engine: engine, cancel: cancel, elector: elector, doer: doer}
+	}
+
+	first := newInstance("first")
+	second := newInstance("second")
+	defer first.cancel()
+	defer second.cancel()
+
+	require.Eventually(t, func() bool {
+		return first.elector.IsLeader() != second.elector.IsLeader() &&
+			first.doer.Count()+second.doer.Count() > 0
+	}, 5*time.Second, 50*time.Millisecond)
+
+	leader, follower := first, second
+	if second.elector.IsLeader() {
+		leader, follower = second, first
+	}
+	// Only the leader schedules jobs.
+	time.Sleep(500 * time.Millisecond)
+	require.True(t, leader.elector.IsLeader())
+	require.False(t, follower.elector.IsLeader())
+	require.Greater(t, leader.doer.Count(), int64(1))
+	require.Equal(t, int64(0), follower.doer.Count())
+
+	// Leader dies without releasing its lease, follower takes over once the
+	// lease expires.
+	leader.cancel()
+	require.Eventually(t, func() bool {
+		return follower.elector.IsLeader() && follower.doer.Count() > 0
+	}, 5*time.Second, 50*time.Millisecond)
+	require.False(t, leader.elector.IsLeader())
+	leaderCount := leader.doer.Count()
+	time.Sleep(300 * time.Millisecond)
+	require.Equal(t, leaderCount, leader.doer.Count())
+}
@@ -0,0 +1,178 @@
+package modules
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/protocol"
+	Logger "github.com/Luismorlan/newsmux/utils/log"
+)
+
+const (
+	defaultAdaptivePollingMaxInterval  = 24 * time.Hour
+	defaultAdaptivePollingTiers        = 3
+	defaultAdaptivePollingLookbackDays = 7
+)
+
+// PollingTierIntervals returns interval of each tier, fastest first, growing
+// geometrically from min to max.
+func PollingTierIntervals(min time.Duration, max time.Duration, tiers int) []time.Duration {
+	if tiers <= 1 || max <= min {
+		return []time.Duration{min}
+	}
+	intervals := make([]time.Duration, tiers)
+	ratio := float64(max) / float64(min)
+	for i := 0; i < tiers; i++ {
+		intervals[i] = time.Duration(float64(min) * math.Pow(ratio, float64(i)/float64(tiers-1)))
+	}
+	return intervals
+}
+
+// PollingTier returns the slowest tier that still polls at least once per post
+// a subsource is expected to make.
+func PollingTier(intervals []time.Duration, postsPerDay float64) int {
+	tier := 0
+	for i, interval := range intervals {
+		if postsPerDay > 0 && float64(interval) > float64(24*time.Hour)/postsPerDay {
+			break
+		}
+		tier = i
+	}
+	return tier
+}
+
+// Subsources in config are matched with stored ones by external id if set,
+// otherwise by name.
+func subSourceKey(externalId string, name string) string {
+	if externalId != "" {
+		return "id:" + externalId
+	}
+	return "name:" + name
+}
+
+// LoadSubSourcePostingRates returns posts per day of each stored subsource of
+// a source since the given time.
+func LoadSubSourcePostingRates(db *gorm.DB, sourceId string, since time.Time) (map[string]float64, error) {
+	var subSources []model.SubSource
+	if err := db.Where("source_id = ?", sourceId).Find(&subSources).Error; err != nil {
+		return nil, err
+	}
+
+	var counts []struct {
+		SubSourceId string
+		Posts       int
+	}
+	if err := db.Model(&model.Post{}).
+		Select("sub_source_id, COUNT(*) AS posts").
+		Where("sub_source_id IN (?) AND content_generated_at >= ?",
+			db.Model(&model.SubSource{}).Select("id").Where("source_id = ?", sourceId), since).
+		Group("sub_source_id").
+		Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	postsById := make(map[string]int)
+	for _, c := range counts {
+		postsById[c.SubSourceId] = c.Posts
+	}
+
+	days := time.Since(since).Hours() / 24
+	rates := make(map[string]float64)
+	for _, s := range subSources {
+		rates[subSourceKey(s.ExternalIdentifier, s.Name)] = float64(postsById[s.Id]) / days
+	}
+	return rates, nil
+}
+
+// SplitByPollingTier splits a config into one config per non-empty tier, each
+// polling its subsources routinely at the tier's interval. Subsources missing
+// from rates are put in the fastest tier.
+func SplitByPollingTier(config *protocol.PanopticConfig, rates map[string]float64) ([]*protocol.PanopticConfig, error) {
+	polling := config.AdaptivePolling
+	min := time.Duration(polling.MinEveryMilliseconds) * time.Millisecond
+	if min <= 0 {
+		min = time.Duration(config.GetTaskSchedule().GetRoutinely().GetEveryMilliseconds()) * time.Millisecond
+	}
+	if min <= 0 {
+		return nil, fmt.Errorf("adaptive polling of config %s requires min interval or routinely schedule", config.Name)
+	}
+	max := time.Duration(polling.MaxEveryMilliseconds) * time.Millisecond
+	if max <= 0 {
+		max = defaultAdaptivePollingMaxInterval
+	}
+	tiers := int(polling.Tiers)
+	if tiers <= 0 {
+		tiers = defaultAdaptivePollingTiers
+	}
+	intervals := PollingTierIntervals(min, max, tiers)
+
+	subSourcesByTier := make([][]*protocol.PanopticSubSource, len(intervals))
+	for _, s := range config.TaskParams.SubSources {
+		tier := 0
+		if rate, ok := rates[subSourceKey(s.ExternalId, s.Name)]; ok {
+			tier = PollingTier(intervals, rate)
+		}
+		subSourcesByTier[tier] = append(subSourcesByTier[tier], s)
+	}
+
+	res := []*protocol.PanopticConfig{}
+	for tier, subSources := range subSourcesByTier {
+		if len(subSources) == 0 {
+			continue
+		}
+		c := proto.Clone(config).(*protocol.PanopticConfig)
+		c.Name = fmt.Sprintf("%s-tier%d", config.Name, tier)
+		c.AdaptivePolling = nil
+		c.TaskParams.SubSources = subSources
+		if c.TaskSchedule == nil {
+			c.TaskSchedule = &protocol.TaskSchedule{}
+		}
+		c.TaskSchedule.Schedule = &protocol.TaskSchedule_Routinely{
+			Routinely: &protocol.Routinely{EveryMilliseconds: intervals[tier].Milliseconds()},
+		}
+		res = append(res, c)
+	}
+	return res, nil
+}
+
+// ApplyAdaptivePolling replaces configs with adaptive polling by their tier
+// configs. A config failing to split is kept as is.
+func ApplyAdaptivePolling(db *gorm.DB, configs *protocol.PanopticConfigs) {
+	res := []*protocol.PanopticConfig{}
+	for _, config := range configs.Config {
+		if config.AdaptivePolling == nil || config.TaskParams == nil {
+			res = append(res, config)
+			continue
+		}
+		if schedule := config.GetTaskSchedule().GetSchedule(); schedule != nil {
+			if _, ok := schedule.(*protocol.TaskSchedule_Routinely); !ok {
+				Logger.Log.Errorf("adaptive polling of config %s ignored, only routinely schedule is supported", config.Name)
+				res = append(res, config)
+				continue
+			}
+		}
+
+		days := config.AdaptivePolling.LookbackDays
+		if days <= 0 {
+			days = defaultAdaptivePollingLookbackDays
+		}
+		rates, err := LoadSubSourcePostingRates(db, config.TaskParams.SourceId, time.Now().AddDate(0, 0, -int(days)))
+		if err != nil {
+			Logger.Log.Errorf("fail to load posting rates of config %s: %v", config.Name, err)
+			res = append(res, config)
+			continue
+		}
+		tierConfigs, err := SplitByPollingTier(config, rates)
+		if err != nil {
+			Logger.Log.Errorf("fail to apply adaptive polling: %v", err)
+			res = append(res, config)
+			continue
+		}
+		res = append(res, tierConfigs...)
+	}
+	configs.Config = res
+}
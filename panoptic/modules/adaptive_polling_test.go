@@ -0,0 +1,89 @@
+package modules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"github.com/Luismorlan/newsmux/protocol"
+)
+
+func TestPollingTierIntervals(t *testing.T) {
+	assert.Equal(t,
+		[]time.Duration{time.Minute, 10 * time.Minute, 100 * time.Minute},
+		PollingTierIntervals(time.Minute, 100*time.Minute, 3))
+	assert.Equal(t, []time.Duration{time.Minute}, PollingTierIntervals(time.Minute, 100*time.Minute, 1))
+	assert.Equal(t, []time.Duration{time.Hour}, PollingTierIntervals(time.Hour, time.Minute, 3))
+}
+
+func TestPollingTier(t *testing.T) {
+	intervals := []time.Duration{10 * time.Minute, time.Hour, 6 * time.Hour}
+	// 200 posts a day, one every 7 minutes.
+	assert.Equal(t, 0, PollingTier(intervals, 200))
+	// 20 posts a day, one every 72 minutes.
+	assert.Equal(t, 1, PollingTier(intervals, 20))
+	assert.Equal(t, 2, PollingTier(intervals, 0.1))
+	assert.Equal(t, 2, PollingTier(intervals, 0))
+}
+
+func TestSplitByPollingTier(t *testing.T) {
+	config := protocol.PanopticConfig{}
+	assert.Nil(t, prototext.Unmarshal([]byte(`
+		name: "weibo"
+		data_collector_id: COLLECTOR_WEIBO
+		task_params: {
+			source_id: "weibo_source_id"
+			sub_sources: { name: "active" external_id: "1" }
+			sub_sources: { name: "normal" external_id: "2" }
+			sub_sources: { name: "quiet" external_id: "3" }
+			sub_sources: { name: "new" external_id: "4" }
+		}
+		task_schedule: {
+			start_immediatly: true
+			routinely: {
+				every_milliseconds: 600000
+			}
+		}
+		adaptive_polling: {
+			max_every_milliseconds: 21600000
+		}
+	`), &config))
+
+	configs, err := SplitByPollingTier(&config, map[string]float64{
+		subSourceKey("1", "active"): 200,
+		subSourceKey("2", "normal"): 20,
+		subSourceKey("3", "quiet"):  0,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(configs))
+
+	names := func(c *protocol.PanopticConfig) []string {
+		res := []string{}
+		for _, s := range c.TaskParams.SubSources {
+			res = append(res, s.Name)
+		}
+		return res
+	}
+	assert.Equal(t, "weibo-tier0", configs[0].Name)
+	assert.Equal(t, []string{"active", "new"}, names(configs[0]))
+	assert.Equal(t, int64(600000), configs[0].TaskSchedule.GetRoutinely().EveryMilliseconds)
+	assert.True(t, configs[0].TaskSchedule.StartImmediatly)
+	assert.Nil(t, configs[0].AdaptivePolling)
+
+	assert.Equal(t, "weibo-tier1", configs[1].Name)
+	assert.Equal(t, []string{"normal"}, names(configs[1]))
+	assert.Equal(t, int64(3600000), configs[1].TaskSchedule.GetRoutinely().EveryMilliseconds)
+
+	assert.Equal(t, "weibo-tier2", configs[2].Name)
+	assert.Equal(t, []string{"quiet"}, names(configs[2]))
+	assert.Equal(t, int64(21600000), configs[2].TaskSchedule.GetRoutinely().EveryMilliseconds)
+
+	// Original config is untouched.
+	assert.Equal(t, 4, len(config.TaskParams.SubSources))
+
+	config.TaskSchedule = nil
+	_, err = SplitByPollingTier(&config, nil)
+	assert.NotNil(t, err)
+}
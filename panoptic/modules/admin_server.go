@@ -0,0 +1,141 @@
+package modules
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Luismorlan/newsmux/panoptic"
+	Logger "github.com/Luismorlan/newsmux/utils/log"
+)
+
+type AdminServerConfig struct {
+	Name string
+	// Address to listen on, e.g. ":8091".
+	Addr string
+	// Shared secret, requests must carry "Authorization: Bearer <Token>".
+	Token string
+}
+
+// AdminServer lets operators inspect and control scheduler jobs at runtime,
+// without editing config and waiting for the poll.
+//
+//	GET  /admin/config                      config digest and loading errors
+//	GET  /admin/jobs                        all jobs with run count and next run
+//	POST /admin/jobs/trigger?name=&dry_run= execute a job immediately
+//	POST /admin/jobs/pause?name=            pause a job
+//	POST /admin/jobs/resume?name=           resume a job
+type AdminServer struct {
+	panoptic.Module
+
+	Config AdminServerConfig
+
+	Scheduler *Scheduler
+
+	Mux *http.ServeMux
+
+	server *http.Server
+}
+
+func NewAdminServer(config AdminServerConfig, scheduler *Scheduler) (*AdminServer, error) {
+	if config.Token == "" {
+		return nil, errors.New("admin server requires a token")
+	}
+	s := &AdminServer{
+		Config:    config,
+		Scheduler: scheduler,
+		Mux:       http.NewServeMux(),
+	}
+	s.Mux.HandleFunc("/admin/config", s.handleGet(func(w http.ResponseWriter, r *http.Request) {
+		WriteJSONStatus(w, scheduler.ConfigStatus())
+	}))
+	s.Mux.HandleFunc("/admin/jobs", s.handleGet(func(w http.ResponseWriter, r *http.Request) {
+		WriteJSONStatus(w, scheduler.JobStatuses())
+	}))
+	s.Mux.HandleFunc("/admin/jobs/trigger", s.handleJobAction(func(name string, r *http.Request) error {
+		dryRun, _ := strconv.ParseBool(r.URL.Query().Get("dry_run"))
+		return scheduler.TriggerJob(name, dryRun)
+	}))
+	s.Mux.HandleFunc("/admin/jobs/pause", s.handleJobAction(func(name string, r *http.Request) error {
+		return scheduler.PauseJob(name, "by admin")
+	}))
+	s.Mux.HandleFunc("/admin/jobs/resume", s.handleJobAction(func(name string, r *http.Request) error {
+		return scheduler.ResumeJob(name, "by admin")
+	}))
+	s.server = &http.Server{Addr: config.Addr, Handler: s.Mux}
+	return s, nil
+}
+
+func (s *AdminServer) authorized(r *http.Request) bool {
+	return subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte("Bearer "+s.Config.Token)) == 1
+}
+
+func (s *AdminServer) handleGet(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// handleJobAction applies action on the job named by query param "name", and
+// responds with the job status after the action.
+func (s *AdminServer) handleJobAction(action func(name string, r *http.Request) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.authorized(r) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		name := r.URL.Query().Get("name")
+		if err := action(name, r); err != nil {
+			if errors.Is(err, ErrJobNotFound) {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			Logger.Log.Errorf("admin action %s on job %s failed: %v", r.URL.Path, name, err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		Logger.Log.Infof("admin action %s on job %s", r.URL.Path, name)
+
+		job, err := s.Scheduler.FindJob(name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		WriteJSONStatus(w, job.Status())
+	}
+}
+
+func (s *AdminServer) RunModule(ctx context.Context) error {
+	Logger.Log.Infoln("admin server listening on", s.Config.Addr)
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *AdminServer) Name() string {
+	return s.Config.Name
+}
+
+func (s *AdminServer) Shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), statusServerShutdownTimeout)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		Logger.Log.Errorf("fail to shutdown admin server: %v", err)
+	}
+	Logger.Log.Infoln("Module ", s.Config.Name, " gracefully shutdown")
+}
@@ -0,0 +1,63 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func doAdminRequest(s *AdminServer, method string, target string, token string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, nil)
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	s.Mux.ServeHTTP(w, r)
+	return w
+}
+
+func TestAdminServer(t *testing.T) {
+	_, err := NewAdminServer(AdminServerConfig{Name: "admin_server"}, &Scheduler{})
+	assert.NotNil(t, err)
+
+	job := GetCustomizedSchedulerJob(t, TestConfig1)
+	scheduler := &Scheduler{
+		ctx:  context.Background(),
+		Doer: &PrinterJobDoer{},
+		Jobs: []*SchedulerJob{job},
+	}
+	s, err := NewAdminServer(AdminServerConfig{Name: "admin_server", Token: "secret"}, scheduler)
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusUnauthorized, doAdminRequest(s, http.MethodGet, "/admin/jobs", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, doAdminRequest(s, http.MethodGet, "/admin/jobs", "wrong").Code)
+	assert.Equal(t, http.StatusMethodNotAllowed, doAdminRequest(s, http.MethodGet, "/admin/jobs/pause?name=cfg_1", "secret").Code)
+	assert.Equal(t, http.StatusNotFound, doAdminRequest(s, http.MethodPost, "/admin/jobs/pause?name=unknown", "secret").Code)
+
+	w := doAdminRequest(s, http.MethodGet, "/admin/jobs", "secret")
+	assert.Equal(t, http.StatusOK, w.Code)
+	statuses := []JobStatus{}
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &statuses))
+	assert.Equal(t, 1, len(statuses))
+	assert.Equal(t, "cfg_1", statuses[0].Name)
+
+	assert.Equal(t, http.StatusOK, doAdminRequest(s, http.MethodPost, "/admin/jobs/pause?name=cfg_1", "secret").Code)
+	assert.True(t, job.IsPaused())
+
+	// Trigger runs a paused job, dry run doesn't count as a run.
+	assert.Equal(t, http.StatusOK, doAdminRequest(s, http.MethodPost, "/admin/jobs/trigger?name=cfg_1&dry_run=true", "secret").Code)
+	assert.Equal(t, int64(0), job.runCount)
+	w = doAdminRequest(s, http.MethodPost, "/admin/jobs/trigger?name=cfg_1", "secret")
+	assert.Equal(t, http.StatusOK, w.Code)
+	status := JobStatus{}
+	assert.Nil(t, json.Unmarshal(w.Body.Bytes(), &status))
+	assert.Equal(t, int64(1), status.RunCount)
+	assert.True(t, status.Paused)
+
+	assert.Equal(t, http.StatusOK, doAdminRequest(s, http.MethodPost, "/admin/jobs/resume?name=cfg_1", "secret").Code)
+	assert.False(t, job.IsPaused())
+}
@@ -0,0 +1,167 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/slack-go/slack"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Luismorlan/newsmux/panoptic"
+	"github.com/Luismorlan/newsmux/protocol"
+	Logger "github.com/Luismorlan/newsmux/utils/log"
+)
+
+const alertWebhookTimeout = 10 * time.Second
+
+// Alerter delivers a PanopticAlert to humans.
+type Alerter interface {
+	Alert(alert *protocol.PanopticAlert) error
+}
+
+// LoggingAlerter writes alerts to log, which is picked up by log monitoring.
+type LoggingAlerter struct{}
+
+func (a *LoggingAlerter) Alert(alert *protocol.PanopticAlert) error {
+	Logger.Log.Errorf("[panoptic alert] %s %s: %s (consecutive failures: %d)",
+		alert.Type.String(), alert.ConfigName, alert.Message, alert.ConsecutiveFailures)
+	return nil
+}
+
+// SlackWebhookAlerter posts alerts to a Slack incoming webhook.
+type SlackWebhookAlerter struct {
+	Url string
+
+	Client *http.Client
+}
+
+func NewSlackWebhookAlerter(url string) *SlackWebhookAlerter {
+	return &SlackWebhookAlerter{
+		Url:    url,
+		Client: &http.Client{Timeout: alertWebhookTimeout},
+	}
+}
+
+func (a *SlackWebhookAlerter) Alert(alert *protocol.PanopticAlert) error {
+	return slack.PostWebhookCustomHTTP(a.Url, a.Client, &slack.WebhookMessage{
+		Text: fmt.Sprintf("*[panoptic] %s* `%s`\n%s", alert.Type.String(), alert.ConfigName, alert.Message),
+	})
+}
+
+// WebhookAlerter posts alerts as JSON encoded PanopticAlert to any http
+// endpoint, e.g. an on-call service.
+type WebhookAlerter struct {
+	Url string
+
+	Client *http.Client
+}
+
+func NewWebhookAlerter(url string) *WebhookAlerter {
+	return &WebhookAlerter{
+		Url:    url,
+		Client: &http.Client{Timeout: alertWebhookTimeout},
+	}
+}
+
+func (a *WebhookAlerter) Alert(alert *protocol.PanopticAlert) error {
+	body, err := protojson.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	resp, err := a.Client.Post(a.Url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("alert webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// PublishAlert publishes alert onto EventBus for AlertDispatcher, nil alert is
+// ignored.
+func PublishAlert(e panoptic.EventBus, alert *protocol.PanopticAlert) {
+	if alert == nil {
+		return
+	}
+	Logger.Log.Warnln(alert.Message)
+	if e == nil {
+		return
+	}
+	data, err := proto.Marshal(alert)
+	if err != nil {
+		Logger.Log.Errorf("fail to marshal alert: %v", err)
+		return
+	}
+	if err := e.Publish(panoptic.TopicAlert, message.NewMessage(watermill.NewUUID(), data)); err != nil {
+		Logger.Log.Errorf("fail to publish alert: %v", err)
+	}
+}
+
+type AlertDispatcherConfig struct {
+	Name string
+}
+
+// AlertDispatcher listens to alerts on EventBus and dispatches them to all
+// alerters.
+type AlertDispatcher struct {
+	panoptic.Module
+
+	Config AlertDispatcherConfig
+
+	Alerters []Alerter
+
+	EventBus panoptic.EventBus
+}
+
+func NewAlertDispatcher(config AlertDispatcherConfig, e panoptic.EventBus, alerters ...Alerter) *AlertDispatcher {
+	return &AlertDispatcher{
+		Config:   config,
+		Alerters: alerters,
+		EventBus: e,
+	}
+}
+
+func (d *AlertDispatcher) Dispatch(alert *protocol.PanopticAlert) {
+	for _, alerter := range d.Alerters {
+		if err := alerter.Alert(alert); err != nil {
+			Logger.Log.Errorf("fail to send alert %s: %v", alert.String(), err)
+		}
+	}
+}
+
+func (d *AlertDispatcher) RunModule(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	messages, err := d.EventBus.Subscribe(ctx, panoptic.TopicAlert, d.Config.Name)
+	if err != nil {
+		return err
+	}
+
+	for msg := range messages {
+		alert := protocol.PanopticAlert{}
+		if err := proto.Unmarshal(msg.Payload, &alert); err != nil {
+			Logger.Log.Errorf("fail to unmarshal alert: %v", err)
+		} else {
+			d.Dispatch(&alert)
+		}
+		msg.Ack()
+	}
+	return nil
+}
+
+func (d *AlertDispatcher) Name() string {
+	return d.Config.Name
+}
+
+func (d *AlertDispatcher) Shutdown() {
+	Logger.Log.Infoln("Module ", d.Config.Name, " gracefully shutdown")
+}
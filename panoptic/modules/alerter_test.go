@@ -0,0 +1,44 @@
+package modules
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Luismorlan/newsmux/protocol"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestWebhookAlerters(t *testing.T) {
+	alert := &protocol.PanopticAlert{
+		Type:       protocol.PanopticAlert_ALERT_CONFIG_SILENT,
+		ConfigName: "jinshi",
+		Message:    "config jinshi collected no message",
+	}
+
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	assert.Nil(t, NewWebhookAlerter(server.URL).Alert(alert))
+	received := protocol.PanopticAlert{}
+	assert.Nil(t, protojson.Unmarshal(body, &received))
+	assert.Equal(t, "jinshi", received.ConfigName)
+	assert.Equal(t, protocol.PanopticAlert_ALERT_CONFIG_SILENT, received.Type)
+
+	assert.Nil(t, NewSlackWebhookAlerter(server.URL).Alert(alert))
+	slackMessage := map[string]interface{}{}
+	assert.Nil(t, json.Unmarshal(body, &slackMessage))
+	assert.Contains(t, slackMessage["text"], "config jinshi collected no message")
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	assert.NotNil(t, NewWebhookAlerter(failing.URL).Alert(alert))
+}
@@ -0,0 +1,238 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/Luismorlan/newsmux/protocol"
+	Logger "github.com/Luismorlan/newsmux/utils/log"
+)
+
+// DomainBudget is the politeness budget of a target domain, shared by all
+// tasks crawling it. Zero means unlimited.
+//
+// Budgets are per panoptic instance, a domain gets N times the budget if N
+// instances are executing jobs.
+type DomainBudget struct {
+	Domain string
+
+	RequestsPerMinute int
+
+	// Max number of tasks crawling the domain at the same time.
+	MaxConcurrency int
+}
+
+type domainLimiter struct {
+	budget DomainBudget
+
+	// Nil if requests are unlimited.
+	requests *rate.Limiter
+
+	// Nil if concurrency is unlimited.
+	slots chan struct{}
+}
+
+func newDomainLimiter(budget DomainBudget) *domainLimiter {
+	l := &domainLimiter{budget: budget}
+	if budget.RequestsPerMinute > 0 {
+		l.requests = rate.NewLimiter(rate.Limit(float64(budget.RequestsPerMinute)/60), budget.RequestsPerMinute)
+	}
+	if budget.MaxConcurrency > 0 {
+		l.slots = make(chan struct{}, budget.MaxConcurrency)
+	}
+	return l
+}
+
+// ErrOverBudget is matched by errors of jobs whose domains are out of budget,
+// the job is not executed.
+var ErrOverBudget = errors.New("domain budget exhausted")
+
+// Delay of a job deferred by concurrency, when it's unknown how soon a running
+// task of the domain finishes.
+const defaultOverBudgetRetryAfter = 30 * time.Second
+
+// OverBudgetError tells which domain is out of budget and how soon the job can
+// be executed again.
+type OverBudgetError struct {
+	Domain     string
+	RetryAfter time.Duration
+}
+
+func (e *OverBudgetError) Error() string {
+	return fmt.Sprintf("%s: %s, retry after %s", ErrOverBudget, e.Domain, e.RetryAfter)
+}
+
+func (e *OverBudgetError) Is(target error) bool {
+	return target == ErrOverBudget
+}
+
+// BudgetedExecutor enforces per domain budgets on top of another executor, so
+// that configs crawling the same domain don't get it banned together. A job
+// is rejected with OverBudgetError unless all domains of its tasks have
+// budget, rather than waiting for it and holding up other jobs of its
+// partition. Orchestrator defers rejected jobs, see DeferJob.
+//
+// Budgets are enforced within this panoptic instance, each attempt of a task
+// is estimated to make one request per subsource. The wrapped executor must
+// call ChargeDomainBudget before retrying a job.
+type BudgetedExecutor struct {
+	executor Executor
+
+	limiters map[string]*domainLimiter
+}
+
+func NewBudgetedExecutor(executor Executor, budgets []DomainBudget) *BudgetedExecutor {
+	limiters := make(map[string]*domainLimiter)
+	for _, budget := range budgets {
+		limiters[budget.Domain] = newDomainLimiter(budget)
+	}
+	return &BudgetedExecutor{
+		executor: executor,
+		limiters: limiters,
+	}
+}
+
+// Estimated number of requests a task makes.
+func estimateTaskRequests(task *protocol.PanopticTask) int {
+	if n := len(task.GetTaskParams().GetSubSources()); n > 0 {
+		return n
+	}
+	return 1
+}
+
+// Requests each budgeted domain of the job takes, domains are returned sorted
+// so that concurrent jobs acquire slots in the same order.
+func (e *BudgetedExecutor) jobRequests(job *protocol.PanopticJob) ([]string, map[string]int) {
+	requests := make(map[string]int)
+	for _, task := range job.Tasks {
+		domain := task.GetTaskMetadata().GetDomain()
+		if _, ok := e.limiters[domain]; !ok {
+			continue
+		}
+		requests[domain] += estimateTaskRequests(task)
+	}
+	domains := make([]string, 0, len(requests))
+	for domain := range requests {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+	return domains, requests
+}
+
+// reserveRequests takes request budget of a domain, returns OverBudgetError
+// if the domain doesn't have enough budget now, in which case nothing is
+// taken.
+func (l *domainLimiter) reserveRequests(now time.Time, n int) (*rate.Reservation, *OverBudgetError) {
+	if l.requests == nil {
+		return nil, nil
+	}
+	// A job larger than the burst takes the whole bucket instead of never
+	// fitting.
+	if n > l.requests.Burst() {
+		n = l.requests.Burst()
+	}
+	r := l.requests.ReserveN(now, n)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return nil, &OverBudgetError{Domain: l.budget.Domain, RetryAfter: delay}
+	}
+	return r, nil
+}
+
+// acquire takes budget of all domains of the job, returns a function to
+// release the concurrency slots, or OverBudgetError if any domain is out of
+// budget. Nothing is taken if the job is over budget.
+func (e *BudgetedExecutor) acquire(job *protocol.PanopticJob) (func(), error) {
+	domains, requests := e.jobRequests(job)
+
+	now := time.Now()
+	held := []*domainLimiter{}
+	reservations := []*rate.Reservation{}
+	release := func() {
+		for _, l := range held {
+			<-l.slots
+		}
+	}
+	reject := func(err *OverBudgetError) (func(), error) {
+		release()
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+		Logger.Log.Infof("job %s deferred, %s", job.JobId, err)
+		return nil, err
+	}
+
+	for _, domain := range domains {
+		l := e.limiters[domain]
+		if l.slots != nil {
+			select {
+			case l.slots <- struct{}{}:
+				held = append(held, l)
+			default:
+				return reject(&OverBudgetError{Domain: domain, RetryAfter: defaultOverBudgetRetryAfter})
+			}
+		}
+		r, err := l.reserveRequests(now, requests[domain])
+		if err != nil {
+			return reject(err)
+		}
+		if r != nil {
+			reservations = append(reservations, r)
+		}
+	}
+	return release, nil
+}
+
+// charge takes request budget of all domains of the job for another attempt,
+// concurrency slots are still held by the first attempt.
+func (e *BudgetedExecutor) charge(job *protocol.PanopticJob) error {
+	domains, requests := e.jobRequests(job)
+
+	now := time.Now()
+	reservations := []*rate.Reservation{}
+	for _, domain := range domains {
+		r, err := e.limiters[domain].reserveRequests(now, requests[domain])
+		if err != nil {
+			for _, r := range reservations {
+				r.CancelAt(now)
+			}
+			return err
+		}
+		if r != nil {
+			reservations = append(reservations, r)
+		}
+	}
+	return nil
+}
+
+type budgetedExecutorKey struct{}
+
+// ChargeDomainBudget takes domain budget for a retry of the job executed with
+// ctx, returns OverBudgetError if its domains are out of budget. It's a no-op
+// if the job is not executed by a BudgetedExecutor.
+func ChargeDomainBudget(ctx context.Context, job *protocol.PanopticJob) error {
+	e, ok := ctx.Value(budgetedExecutorKey{}).(*BudgetedExecutor)
+	if !ok {
+		return nil
+	}
+	return e.charge(job)
+}
+
+func (e *BudgetedExecutor) Execute(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+	release, err := e.acquire(job)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return e.executor.Execute(context.WithValue(ctx, budgetedExecutorKey{}, e), job)
+}
+
+func (e *BudgetedExecutor) Shutdown() {
+	e.executor.Shutdown()
+}
@@ -0,0 +1,146 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Luismorlan/newsmux/protocol"
+	"github.com/stretchr/testify/assert"
+)
+
+type concurrencyRecordingExecutor struct {
+	running int32
+	max     int32
+}
+
+func (e *concurrencyRecordingExecutor) Execute(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+	running := atomic.AddInt32(&e.running, 1)
+	for {
+		max := atomic.LoadInt32(&e.max)
+		if running <= max || atomic.CompareAndSwapInt32(&e.max, max, running) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	atomic.AddInt32(&e.running, -1)
+	return job, nil
+}
+
+func (e *concurrencyRecordingExecutor) Shutdown() {}
+
+func newBudgetTestJob(domain string, subsources int) *protocol.PanopticJob {
+	params := &protocol.TaskParams{}
+	for i := 0; i < subsources; i++ {
+		params.SubSources = append(params.SubSources, &protocol.PanopticSubSource{})
+	}
+	return &protocol.PanopticJob{
+		Tasks: []*protocol.PanopticTask{{
+			TaskParams:   params,
+			TaskMetadata: &protocol.TaskMetadata{Domain: domain},
+		}},
+	}
+}
+
+func TestBudgetedExecutor_Concurrency(t *testing.T) {
+	inner := &concurrencyRecordingExecutor{}
+	e := NewBudgetedExecutor(inner, []DomainBudget{{Domain: "weibo.com", MaxConcurrency: 2}})
+
+	// Jobs beyond the concurrency are rejected rather than waiting.
+	var wg sync.WaitGroup
+	var skipped int32
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := e.Execute(context.Background(), newBudgetTestJob("weibo.com", 1))
+			if err != nil {
+				assert.ErrorIs(t, err, ErrOverBudget)
+				atomic.AddInt32(&skipped, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.LessOrEqual(t, atomic.LoadInt32(&inner.max), int32(2))
+	assert.Greater(t, atomic.LoadInt32(&skipped), int32(0))
+
+	// Slots are released after execution.
+	_, err := e.Execute(context.Background(), newBudgetTestJob("weibo.com", 1))
+	assert.Nil(t, err)
+
+	// Domains without budget are not limited.
+	inner = &concurrencyRecordingExecutor{}
+	e = NewBudgetedExecutor(inner, []DomainBudget{{Domain: "weibo.com", MaxConcurrency: 1}})
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := e.Execute(context.Background(), newBudgetTestJob("caixin.com", 1))
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+	assert.Greater(t, atomic.LoadInt32(&inner.max), int32(1))
+}
+
+func TestBudgetedExecutor_RequestsPerMinute(t *testing.T) {
+	e := NewBudgetedExecutor(&concurrencyRecordingExecutor{}, []DomainBudget{{Domain: "weibo.com", RequestsPerMinute: 60}})
+
+	// A job larger than the budget takes the whole bucket.
+	_, err := e.Execute(context.Background(), newBudgetTestJob("weibo.com", 100))
+	assert.Nil(t, err)
+
+	// The bucket refills at 1 request per second, the next job is rejected
+	// without waiting.
+	start := time.Now()
+	_, err = e.Execute(context.Background(), newBudgetTestJob("weibo.com", 1))
+	assert.ErrorIs(t, err, ErrOverBudget)
+	assert.Less(t, int64(time.Since(start)), int64(100*time.Millisecond))
+
+	_, err = e.Execute(context.Background(), newBudgetTestJob("caixin.com", 1))
+	assert.Nil(t, err)
+}
+
+// retryingExecutor charges domain budget for each retry like LambdaExecutor.
+type retryingExecutor struct {
+	attempts int
+}
+
+func (e *retryingExecutor) Execute(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+	for i := 1; i < e.attempts; i++ {
+		if err := ChargeDomainBudget(ctx, job); err != nil {
+			return nil, err
+		}
+	}
+	return job, nil
+}
+
+func (e *retryingExecutor) Shutdown() {}
+
+func TestBudgetedExecutor_RetryAfter(t *testing.T) {
+	e := NewBudgetedExecutor(&concurrencyRecordingExecutor{}, []DomainBudget{{Domain: "weibo.com", RequestsPerMinute: 60}})
+
+	_, err := e.Execute(context.Background(), newBudgetTestJob("weibo.com", 60))
+	assert.Nil(t, err)
+	_, err = e.Execute(context.Background(), newBudgetTestJob("weibo.com", 2))
+	var overBudget *OverBudgetError
+	assert.True(t, errors.As(err, &overBudget))
+	assert.Equal(t, "weibo.com", overBudget.Domain)
+	assert.Greater(t, int64(overBudget.RetryAfter), int64(time.Second))
+	assert.LessOrEqual(t, int64(overBudget.RetryAfter), int64(2*time.Second))
+}
+
+func TestBudgetedExecutor_ChargesRetries(t *testing.T) {
+	e := NewBudgetedExecutor(&retryingExecutor{attempts: 3}, []DomainBudget{{Domain: "weibo.com", RequestsPerMinute: 5}})
+
+	// Each attempt takes 2 requests, the third one is out of budget.
+	_, err := e.Execute(context.Background(), newBudgetTestJob("weibo.com", 2))
+	assert.ErrorIs(t, err, ErrOverBudget)
+
+	// Executors not wrapped by budget are not charged.
+	_, err = (&retryingExecutor{attempts: 3}).Execute(context.Background(), newBudgetTestJob("weibo.com", 2))
+	assert.Nil(t, err)
+}
@@ -0,0 +1,398 @@
+package modules
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/go-github/github"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/encoding/prototext"
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/app_setting"
+	"github.com/Luismorlan/newsmux/panoptic"
+	"github.com/Luismorlan/newsmux/protocol"
+	"github.com/Luismorlan/newsmux/utils"
+	Logger "github.com/Luismorlan/newsmux/utils/log"
+)
+
+const (
+	ConfigSourceTypeFile   = "file"
+	ConfigSourceTypeDir    = "dir"
+	ConfigSourceTypeGithub = "github"
+	ConfigSourceTypeDB     = "db"
+	ConfigSourceTypeHttp   = "http"
+
+	textprotoExt = ".textproto"
+
+	defaultGithubConfigOwner = "Luismorlan"
+	defaultGithubConfigRepo  = "panoptic_config"
+	defaultGithubConfigPath  = "config.textproto"
+)
+
+// ConfigSource provides PanopticConfigs for Scheduler. Scheduler polls
+// Read every SCHEDULER_CONFIG_POLL_INTERVAL_SECOND, and additionally whenever
+// Changed is notified.
+type ConfigSource interface {
+	// Name identifies the source in logs and status.
+	Name() string
+
+	// Read returns the latest configs.
+	Read(ctx context.Context) (*protocol.PanopticConfigs, error)
+
+	// Changed is notified when configs might have changed. Returns nil if the
+	// source can only be polled.
+	Changed() <-chan struct{}
+}
+
+// notify sends a non-blocking notification, multiple notifications before
+// the receiver wakes up are coalesced into one.
+func notify(changed chan struct{}) {
+	select {
+	case changed <- struct{}{}:
+	default:
+	}
+}
+
+// watchDir notifies changed on any event of files in dir matching match, until
+// ctx is done. Directory is watched instead of the file itself since editors
+// and config sync tools usually replace a file by renaming.
+func watchDir(ctx context.Context, dir string, match func(path string) bool, changed chan struct{}) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return err
+	}
+
+	go func() {
+		defer watcher.Close()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if match(filepath.Clean(event.Name)) {
+					notify(changed)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				Logger.Log.Errorf("error watching config dir %s: %v", dir, err)
+			}
+		}
+	}()
+	return nil
+}
+
+// FileConfigSource reads a single textproto file, reloaded on file change.
+type FileConfigSource struct {
+	Path    string
+	changed chan struct{}
+}
+
+// NewFileConfigSource watches the file until ctx is done, falls back to
+// polling if the file can't be watched.
+func NewFileConfigSource(ctx context.Context, path string) *FileConfigSource {
+	s := &FileConfigSource{Path: path, changed: make(chan struct{}, 1)}
+	target := filepath.Clean(path)
+	if err := watchDir(ctx, filepath.Dir(target), func(p string) bool { return p == target }, s.changed); err != nil {
+		Logger.Log.Errorf("fail to watch config file %s, fall back to polling: %v", path, err)
+		s.changed = nil
+	}
+	return s
+}
+
+func (s *FileConfigSource) Name() string {
+	return ConfigSourceTypeFile + ":" + s.Path
+}
+
+func (s *FileConfigSource) Read(ctx context.Context) (*protocol.PanopticConfigs, error) {
+	in, err := ioutil.ReadFile(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	configs := &protocol.PanopticConfigs{}
+	if err := prototext.Unmarshal(in, configs); err != nil {
+		return nil, fmt.Errorf("fail to parse %s: %w", s.Path, err)
+	}
+	return configs, nil
+}
+
+func (s *FileConfigSource) Changed() <-chan struct{} {
+	return s.changed
+}
+
+// DirConfigSource reads all .textproto files in a directory, each file is a
+// PanopticConfigs fragment. Fragments are concatenated in file name order.
+type DirConfigSource struct {
+	Dir     string
+	changed chan struct{}
+}
+
+// NewDirConfigSource watches the directory until ctx is done, falls back to
+// polling if the directory can't be watched.
+func NewDirConfigSource(ctx context.Context, dir string) *DirConfigSource {
+	s := &DirConfigSource{Dir: dir, changed: make(chan struct{}, 1)}
+	if err := watchDir(ctx, dir, func(p string) bool { return filepath.Ext(p) == textprotoExt }, s.changed); err != nil {
+		Logger.Log.Errorf("fail to watch config dir %s, fall back to polling: %v", dir, err)
+		s.changed = nil
+	}
+	return s
+}
+
+func (s *DirConfigSource) Name() string {
+	return ConfigSourceTypeDir + ":" + s.Dir
+}
+
+func (s *DirConfigSource) Read(ctx context.Context) (*protocol.PanopticConfigs, error) {
+	paths, err := filepath.Glob(filepath.Join(s.Dir, "*"+textprotoExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	configs := &protocol.PanopticConfigs{}
+	for _, path := range paths {
+		fragment, err := (&FileConfigSource{Path: path}).Read(ctx)
+		if err != nil {
+			return nil, err
+		}
+		configs.Config = append(configs.Config, fragment.Config...)
+	}
+	return configs, nil
+}
+
+func (s *DirConfigSource) Changed() <-chan struct{} {
+	return s.changed
+}
+
+// GithubConfigSource reads a textproto file from a Github repository.
+type GithubConfigSource struct {
+	Owner string
+	Repo  string
+	Path  string
+	// Access token for private repository.
+	Token string
+}
+
+func (s *GithubConfigSource) Name() string {
+	return fmt.Sprintf("%s:%s/%s/%s", ConfigSourceTypeGithub, s.Owner, s.Repo, s.Path)
+}
+
+func (s *GithubConfigSource) Read(ctx context.Context) (*protocol.PanopticConfigs, error) {
+	ts := oauth2.StaticTokenSource(
+		&oauth2.Token{AccessToken: s.Token},
+	)
+	client := github.NewClient(oauth2.NewClient(ctx, ts))
+	content, _, res, err := client.Repositories.GetContents(ctx, s.Owner, s.Repo, s.Path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("fail to get config from Github, http code %d", res.StatusCode)
+	}
+	decode, err := base64.StdEncoding.DecodeString(*content.Content)
+	if err != nil {
+		return nil, err
+	}
+	configs := &protocol.PanopticConfigs{}
+	if err := prototext.Unmarshal(decode, configs); err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
+
+func (s *GithubConfigSource) Changed() <-chan struct{} {
+	return nil
+}
+
+// DBConfigSource reads source level customized crawler configs created by
+// users, which are stored with sources.
+type DBConfigSource struct {
+	DB *gorm.DB
+}
+
+func (s *DBConfigSource) Name() string {
+	return ConfigSourceTypeDB
+}
+
+func (s *DBConfigSource) Read(ctx context.Context) (*protocol.PanopticConfigs, error) {
+	if s.DB == nil {
+		return nil, fmt.Errorf("database is not connected")
+	}
+	configs := &protocol.PanopticConfigs{}
+	if err := panoptic.MergeCustomizedSourceConfigsFromDb(s.DB.WithContext(ctx), configs); err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
+
+func (s *DBConfigSource) Changed() <-chan struct{} {
+	return nil
+}
+
+// HttpConfigSource reads a textproto from an http url.
+type HttpConfigSource struct {
+	Url    string
+	Client *http.Client
+}
+
+func (s *HttpConfigSource) Name() string {
+	return ConfigSourceTypeHttp + ":" + s.Url
+}
+
+func (s *HttpConfigSource) Read(ctx context.Context) (*protocol.PanopticConfigs, error) {
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fail to get config from %s, http code %d", s.Url, res.StatusCode)
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	configs := &protocol.PanopticConfigs{}
+	if err := prototext.Unmarshal(body, configs); err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
+
+func (s *HttpConfigSource) Changed() <-chan struct{} {
+	return nil
+}
+
+// LayeredConfigSource combines multiple sources, a config in a later layer
+// overrides the config with the same name in earlier layers. If any layer
+// fails, the whole read fails so that scheduler keeps the last good config
+// instead of dropping the failed layer's jobs.
+type LayeredConfigSource struct {
+	Layers  []ConfigSource
+	changed chan struct{}
+}
+
+// NewLayeredConfigSource forwards change notification of all layers until
+// ctx is done.
+func NewLayeredConfigSource(ctx context.Context, layers ...ConfigSource) *LayeredConfigSource {
+	s := &LayeredConfigSource{Layers: layers, changed: make(chan struct{}, 1)}
+	for _, layer := range layers {
+		changed := layer.Changed()
+		if changed == nil {
+			continue
+		}
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-changed:
+					notify(s.changed)
+				}
+			}
+		}()
+	}
+	return s
+}
+
+func (s *LayeredConfigSource) Name() string {
+	names := []string{}
+	for _, layer := range s.Layers {
+		names = append(names, layer.Name())
+	}
+	return "layered(" + strings.Join(names, ", ") + ")"
+}
+
+func (s *LayeredConfigSource) Read(ctx context.Context) (*protocol.PanopticConfigs, error) {
+	res := &protocol.PanopticConfigs{}
+	indexByName := map[string]int{}
+	for _, layer := range s.Layers {
+		configs, err := layer.Read(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fail to read config source %s: %w", layer.Name(), err)
+		}
+		for _, config := range configs.Config {
+			if idx, ok := indexByName[config.Name]; ok {
+				res.Config[idx] = config
+				continue
+			}
+			indexByName[config.Name] = len(res.Config)
+			res.Config = append(res.Config, config)
+		}
+	}
+	return res, nil
+}
+
+func (s *LayeredConfigSource) Changed() <-chan struct{} {
+	return s.changed
+}
+
+// NewConfigSourceFromAppSetting builds the layered config source described by
+// CONFIG_SOURCES, or the default layers if it's empty.
+func NewConfigSourceFromAppSetting(ctx context.Context, setting *app_setting.PanopticAppSetting, db *gorm.DB) (ConfigSource, error) {
+	settings := setting.CONFIG_SOURCES
+	if len(settings) == 0 {
+		settings = []app_setting.ConfigSourceSetting{{TYPE: ConfigSourceTypeDB}}
+		if setting.FORCE_REMOTE_SCHEDULE_PULL || utils.IsProdEnv() {
+			settings = append(settings, app_setting.ConfigSourceSetting{TYPE: ConfigSourceTypeGithub})
+		} else {
+			settings = append(settings, app_setting.ConfigSourceSetting{TYPE: ConfigSourceTypeFile, PATH: setting.LOCAL_PANOPTIC_CONFIG_PATH})
+		}
+	}
+
+	layers := []ConfigSource{}
+	for _, s := range settings {
+		switch s.TYPE {
+		case ConfigSourceTypeFile:
+			layers = append(layers, NewFileConfigSource(ctx, s.PATH))
+		case ConfigSourceTypeDir:
+			layers = append(layers, NewDirConfigSource(ctx, s.PATH))
+		case ConfigSourceTypeGithub:
+			source := &GithubConfigSource{
+				Owner: s.OWNER,
+				Repo:  s.REPO,
+				Path:  s.PATH,
+				Token: os.Getenv("GITHUB_ACCESS_TOKEN"),
+			}
+			if source.Owner == "" && source.Repo == "" {
+				source.Owner, source.Repo = defaultGithubConfigOwner, defaultGithubConfigRepo
+			}
+			if source.Path == "" {
+				source.Path = defaultGithubConfigPath
+			}
+			layers = append(layers, source)
+		case ConfigSourceTypeDB:
+			layers = append(layers, &DBConfigSource{DB: db})
+		case ConfigSourceTypeHttp:
+			layers = append(layers, &HttpConfigSource{Url: s.URL})
+		default:
+			return nil, fmt.Errorf("unknown config source type: %s", s.TYPE)
+		}
+	}
+	return NewLayeredConfigSource(ctx, layers...), nil
+}
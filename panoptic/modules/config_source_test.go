@@ -0,0 +1,192 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"github.com/Luismorlan/newsmux/app_setting"
+	"github.com/Luismorlan/newsmux/protocol"
+)
+
+// Static config source for testing.
+type fakeConfigSource struct {
+	name    string
+	configs string
+	err     error
+}
+
+func (s *fakeConfigSource) Name() string {
+	return s.name
+}
+
+func (s *fakeConfigSource) Read(ctx context.Context) (*protocol.PanopticConfigs, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	configs := &protocol.PanopticConfigs{}
+	if err := prototext.Unmarshal([]byte(s.configs), configs); err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
+
+func (s *fakeConfigSource) Changed() <-chan struct{} {
+	return nil
+}
+
+func configNames(configs *protocol.PanopticConfigs) []string {
+	names := []string{}
+	for _, config := range configs.Config {
+		names = append(names, config.Name)
+	}
+	return names
+}
+
+func wrapConfigs(configs ...string) string {
+	res := ""
+	for _, config := range configs {
+		res += "config: {" + config + "}\n"
+	}
+	return res
+}
+
+func TestLayeredConfigSource_LaterLayerOverrides(t *testing.T) {
+	override := `
+		name: "cfg_2"
+		data_collector_id: COLLECTOR_WEIBO
+	`
+	source := NewLayeredConfigSource(context.Background(),
+		&fakeConfigSource{name: "base", configs: wrapConfigs(TestConfig1, TestConfig2)},
+		&fakeConfigSource{name: "override", configs: wrapConfigs(override, TestConfig3)},
+	)
+
+	configs, err := source.Read(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cfg_1", "cfg_2", "cfg_3"}, configNames(configs))
+	assert.Equal(t, protocol.PanopticTask_COLLECTOR_WEIBO, configs.Config[1].DataCollectorId)
+	assert.Equal(t, "layered(base, override)", source.Name())
+}
+
+func TestLayeredConfigSource_FailsIfAnyLayerFails(t *testing.T) {
+	source := NewLayeredConfigSource(context.Background(),
+		&fakeConfigSource{name: "base", configs: wrapConfigs(TestConfig1)},
+		&fakeConfigSource{name: "broken", err: fmt.Errorf("unavailable")},
+	)
+
+	_, err := source.Read(context.Background())
+	assert.Error(t, err)
+}
+
+func TestDirConfigSource_ConcatenatesFragments(t *testing.T) {
+	dir, err := ioutil.TempDir("", "panoptic_config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "b.textproto"), []byte(wrapConfigs(TestConfig2)), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "a.textproto"), []byte(wrapConfigs(TestConfig1)), 0644))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "README.md"), []byte("not a config"), 0644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	configs, err := NewDirConfigSource(ctx, dir).Read(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cfg_1", "cfg_2"}, configNames(configs))
+}
+
+func TestFileConfigSource_NotifiesOnChange(t *testing.T) {
+	dir, err := ioutil.TempDir("", "panoptic_config")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.textproto")
+	require.NoError(t, ioutil.WriteFile(path, []byte(wrapConfigs(TestConfig1)), 0644))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	source := NewFileConfigSource(ctx, path)
+	require.NotNil(t, source.Changed())
+
+	configs, err := source.Read(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cfg_1"}, configNames(configs))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(wrapConfigs(TestConfig1, TestConfig2)), 0644))
+	select {
+	case <-source.Changed():
+	case <-time.After(5 * time.Second):
+		t.Fatal("no change notification after file is written")
+	}
+
+	configs, err = source.Read(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cfg_1", "cfg_2"}, configNames(configs))
+}
+
+func TestHttpConfigSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/config.textproto" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(wrapConfigs(TestConfig1)))
+	}))
+	defer server.Close()
+
+	configs, err := (&HttpConfigSource{Url: server.URL + "/config.textproto"}).Read(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cfg_1"}, configNames(configs))
+
+	_, err = (&HttpConfigSource{Url: server.URL + "/missing"}).Read(context.Background())
+	assert.Error(t, err)
+}
+
+func TestNewConfigSourceFromAppSetting_UnknownType(t *testing.T) {
+	_, err := NewConfigSourceFromAppSetting(context.Background(), &app_setting.PanopticAppSetting{
+		CONFIG_SOURCES: []app_setting.ConfigSourceSetting{{TYPE: "ftp"}},
+	}, nil)
+	assert.Error(t, err)
+}
+
+func TestParseAndUpsertJobs_KeepsLastGoodConfig(t *testing.T) {
+	source := &fakeConfigSource{name: "fake", configs: wrapConfigs(TestConfig1, TestConfig2)}
+	s := &Scheduler{ctx: context.Background(), ConfigSource: source}
+
+	reschedule, err := s.ParseAndUpsertJobs()
+	s.recordConfigLoad(err)
+	require.NoError(t, err)
+	assert.True(t, reschedule)
+	digest := s.ScheduleDigest
+
+	// Duplicate job names are rejected as a whole.
+	source.configs = wrapConfigs(TestConfig1, TestConfig1)
+	reschedule, err = s.ParseAndUpsertJobs()
+	s.recordConfigLoad(err)
+	assert.Error(t, err)
+	assert.False(t, reschedule)
+
+	status := s.ConfigStatus()
+	assert.Equal(t, digest, status.Digest)
+	assert.Equal(t, 2, status.JobCount)
+	assert.Equal(t, 1, status.ConsecutiveErrors)
+	assert.Contains(t, status.LastError, "duplicate scheduler job name")
+	assert.NotNil(t, status.LastLoadedAt)
+
+	source.configs = wrapConfigs(TestConfig1)
+	_, err = s.ParseAndUpsertJobs()
+	s.recordConfigLoad(err)
+	require.NoError(t, err)
+	status = s.ConfigStatus()
+	assert.Equal(t, 1, status.JobCount)
+	assert.Equal(t, 0, status.ConsecutiveErrors)
+	assert.Empty(t, status.LastError)
+}
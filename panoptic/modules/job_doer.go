@@ -8,7 +8,6 @@ import (
 	"github.com/Luismorlan/newsmux/utils"
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
-	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/proto"
 )
@@ -22,12 +21,16 @@ type JobDoer interface {
 }
 
 type SchedulerJobDoer struct {
-	EventBus *gochannel.GoChannel
+	EventBus panoptic.EventBus
+
+	// Number of pending job partitions, must be the same as orchestrator's.
+	Partitions int
 }
 
-func NewSchedulerJobDoer(e *gochannel.GoChannel) *SchedulerJobDoer {
+func NewSchedulerJobDoer(e panoptic.EventBus, partitions int) *SchedulerJobDoer {
 	return &SchedulerJobDoer{
-		EventBus: e,
+		EventBus:   e,
+		Partitions: partitions,
 	}
 }
 
@@ -41,6 +44,8 @@ func (d *SchedulerJobDoer) Do(job *SchedulerJob) error {
 		TaskParams:      job.panopticConfig.TaskParams,
 		TaskMetadata: &protocol.TaskMetadata{
 			ConfigName: job.panopticConfig.Name,
+			Domain:     job.panopticConfig.Domain,
+			DryRun:     job.panopticConfig.DryRun,
 		},
 	})
 
@@ -55,7 +60,10 @@ func (d *SchedulerJobDoer) Do(job *SchedulerJob) error {
 	}
 
 	msg := message.NewMessage(watermill.NewUUID(), data)
-	d.EventBus.Publish(panoptic.TopicPendingJob, msg)
+	partition := panoptic.PendingJobPartition(job.panopticConfig.Name, d.Partitions)
+	if err := d.EventBus.Publish(panoptic.PendingJobTopic(partition), msg); err != nil {
+		return err
+	}
 
 	job.IncrementRunCount()
 
@@ -8,7 +8,6 @@ import (
 	"github.com/Luismorlan/newsmux/protocol"
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
-	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/proto"
@@ -18,10 +17,8 @@ import (
 func TestSchedulerJobDoer(t *testing.T) {
 	job := GetDefaultSchedulerJob(t)
 
-	eventbus := gochannel.NewGoChannel(
-		gochannel.Config{},
-		watermill.NewStdLogger(false, false),
-	)
+	eventbus := panoptic.NewGoChannelEventBus(watermill.NewStdLogger(false, false))
+	partitions := 4
 	ctx := context.Background()
 
 	// Go channel receive and send cannot be in the same routine, otherwise it
@@ -30,12 +27,14 @@ func TestSchedulerJobDoer(t *testing.T) {
 	done := make(chan int)
 	// Receiver
 	messages, err := eventbus.Subscribe(
-		ctx, panoptic.TopicPendingJob)
+		ctx,
+		panoptic.PendingJobTopic(panoptic.PendingJobPartition(job.panopticConfig.Name, partitions)),
+		"orchestrator")
 	assert.Nil(t, err)
 
 	go func() {
 		// Publisher
-		schedulerJobDoer := NewSchedulerJobDoer(eventbus)
+		schedulerJobDoer := NewSchedulerJobDoer(eventbus, partitions)
 		assert.Nil(t, schedulerJobDoer.Do(job))
 		assert.Equal(t, job.runCount, int64(1))
 	}()
@@ -70,3 +69,13 @@ func TestSchedulerJobDoer(t *testing.T) {
 		job.panopticConfig.DataCollectorId,
 		panopticJob.Tasks[0].DataCollectorId)
 }
+
+func TestPendingJobPartition(t *testing.T) {
+	assert.Equal(t, 0, panoptic.PendingJobPartition("weibo", 1))
+	assert.Equal(t, 0, panoptic.PendingJobPartition("weibo", 0))
+
+	partition := panoptic.PendingJobPartition("weibo", 32)
+	assert.True(t, partition >= 0 && partition < 32)
+	assert.Equal(t, partition, panoptic.PendingJobPartition("weibo", 32))
+	assert.Equal(t, "topic.pending_job.3", panoptic.PendingJobTopic(3))
+}
@@ -0,0 +1,39 @@
+package modules
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Luismorlan/newsmux/model"
+)
+
+// SaveJobPause persists an admin pause of the config.
+func SaveJobPause(db *gorm.DB, configName string, reason string) error {
+	return db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "config_name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason"}),
+	}).Create(&model.PanopticJobPause{ConfigName: configName, Reason: reason}).Error
+}
+
+// DeleteJobPause removes the admin pause of the config if any.
+func DeleteJobPause(db *gorm.DB, configName string) error {
+	return db.Where("config_name = ?", configName).Delete(&model.PanopticJobPause{}).Error
+}
+
+// PausedConfigNames returns the set of config names paused by admin, if names
+// is non-empty only those configs are looked up.
+func PausedConfigNames(db *gorm.DB, names ...string) (map[string]bool, error) {
+	var pauses []model.PanopticJobPause
+	query := db.Model(&model.PanopticJobPause{})
+	if len(names) > 0 {
+		query = query.Where("config_name IN ?", names)
+	}
+	if err := query.Find(&pauses).Error; err != nil {
+		return nil, err
+	}
+	paused := make(map[string]bool)
+	for _, p := range pauses {
+		paused[p.ConfigName] = true
+	}
+	return paused, nil
+}
@@ -16,6 +16,7 @@ import (
 	Logger "github.com/Luismorlan/newsmux/utils/log"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"golang.org/x/time/rate"
 )
 
 // Configuration of the lambda executor.
@@ -29,8 +30,25 @@ type LambdaExecutorConfig struct {
 
 	// Maintain the lambda pool every other interval.
 	MaintainEverySecond int64
+
+	// Max number of invocations of a job, including the first one. A failed
+	// invocation is retried on a different function.
+	MaxAttempts int
+
+	// Backoff before the first retry, doubled on each following retry.
+	RetryBackoffMillisecond int64
+
+	// Max number of retries of each config per hour, so that a broken data
+	// collector doesn't keep occupying the pool.
+	RetryBudgetPerConfigPerHour int
 }
 
+const (
+	defaultLambdaMaxAttempts        = 3
+	defaultLambdaRetryBackoff       = 1 * time.Second
+	defaultLambdaRetryBudgetPerHour = 20
+)
+
 // LambdaFunction maintains the in-memory state for the LambdaFunction, which
 // contains the function state, as well as jobs on the function.
 type LambdaFunction struct {
@@ -44,6 +62,10 @@ type LambdaFunction struct {
 	// Pending jobs on this Lambda function, keyed by JobId.
 	m    sync.RWMutex
 	jobs map[string]*protocol.PanopticJob
+	// Set when an invocation on this function fails by its fault, see
+	// isFunctionFault. A suspicious function is not picked for new jobs and is
+	// retired on the next maintenance.
+	suspicious bool
 }
 
 // Lambda Executor executes jobs on AWS lambda, it maintains a list of active
@@ -66,6 +88,13 @@ type LambdaExecutor struct {
 
 	// AWS Lambda Client that actually in charge of executing lambda function
 	lambdaClient *lambda.Client
+
+	// Invokes a job on the named function, defaults to MakeDataCollectorRpc.
+	invoke func(ctx context.Context, job *protocol.PanopticJob, functionName string) (*protocol.PanopticJob, error)
+
+	// Retry budget of each config, keyed by config name.
+	budgetM      sync.Mutex
+	retryBudgets map[string]*rate.Limiter
 }
 
 // TODO(chenweilunster): Remove this once api is unified.
@@ -76,7 +105,7 @@ type DataCollectorRequest struct {
 // Create an uninitialized LambdaExecutor.
 func NewLambdaExecutor(ctx context.Context,
 	client *lambda.Client, cfg *LambdaExecutorConfig) *LambdaExecutor {
-	return &LambdaExecutor{
+	l := &LambdaExecutor{
 		m:            sync.RWMutex{},
 		config:       cfg,
 		ctx:          ctx,
@@ -84,7 +113,12 @@ func NewLambdaExecutor(ctx context.Context,
 		lambdaClient: client,
 		pool:         []*LambdaFunction{},
 		stalePool:    sync.Map{},
+		retryBudgets: make(map[string]*rate.Limiter),
+	}
+	l.invoke = func(ctx context.Context, job *protocol.PanopticJob, functionName string) (*protocol.PanopticJob, error) {
+		return MakeDataCollectorRpc(ctx, job, functionName, l.lambdaClient)
 	}
+	return l
 }
 
 func NewLambdaFunction(out *lambda.CreateFunctionOutput, span time.Duration) (*LambdaFunction, error) {
@@ -143,14 +177,46 @@ func MakeDataCollectorRpc(ctx context.Context, job *protocol.PanopticJob, functi
 		return nil, err
 	}
 
-	// If timeout.
+	// Collector failed or timed out.
 	if res.FunctionError != nil {
-		return nil, errors.New(*res.FunctionError)
+		return nil, &CollectorError{Message: *res.FunctionError}
 	}
 
 	return model.LambdaPayloadToPanopticJob(res.Payload)
 }
 
+// CollectorError is returned when the job reached the function but collector
+// failed or timed out, which is caused by the job rather than the function.
+type CollectorError struct {
+	Message string
+}
+
+func (e *CollectorError) Error() string {
+	return "collector error: " + e.Message
+}
+
+// isFunctionFault tells whether a failed invocation is to blame on the function
+// itself rather than the job: the function is throttled, missing or not
+// ready, or Lambda API fails with 5xx. Collector errors are never blamed on
+// the function, since a broken config would retire every function it's
+// retried on.
+func isFunctionFault(err error) bool {
+	var collectorErr *CollectorError
+	if errors.As(err, &collectorErr) {
+		return false
+	}
+	var throttled *types.TooManyRequestsException
+	var notFound *types.ResourceNotFoundException
+	var notReady *types.ResourceNotReadyException
+	var serviceErr *types.ServiceException
+	if errors.As(err, &throttled) || errors.As(err, &notFound) ||
+		errors.As(err, &notReady) || errors.As(err, &serviceErr) {
+		return true
+	}
+	var httpErr interface{ HTTPStatusCode() int }
+	return errors.As(err, &httpErr) && httpErr.HTTPStatusCode() >= 500
+}
+
 // A function can be removed if it contains no pending jobs
 func (f *LambdaFunction) IsRemovable() bool {
 	f.m.RLock()
@@ -180,6 +246,21 @@ func (f *LambdaFunction) DeletePendingJob(job *protocol.PanopticJob) {
 	delete(f.jobs, job.JobId)
 }
 
+// Mark the function as suspicious after an invocation failed by its fault.
+func (f *LambdaFunction) MarkSuspicious() {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	f.suspicious = true
+}
+
+func (f *LambdaFunction) IsSuspicious() bool {
+	f.m.RLock()
+	defer f.m.RUnlock()
+
+	return f.suspicious
+}
+
 // Init initialize the Lambda pool this executor maintains, make sure they are
 // created with the already uploaded image. It also spins up a garbage cleaner
 // goroutine that retires stale
@@ -351,7 +432,7 @@ func (l *LambdaExecutor) ReportLambdaPools() {
 		stalePoolSize)
 }
 
-// Move all stale functions from active pool to stale pool.
+// Move all stale and suspicious functions from active pool to stale pool.
 func (l *LambdaExecutor) MarkStaleFunctions() {
 	l.m.Lock()
 	defer l.m.Unlock()
@@ -359,7 +440,7 @@ func (l *LambdaExecutor) MarkStaleFunctions() {
 	i := 0
 	for i < len(l.pool) {
 		f := l.pool[i]
-		if f.IsStale() {
+		if f.IsStale() && !f.IsSuspicious() {
 			i++
 			continue
 		}
@@ -403,6 +484,13 @@ func (l *LambdaExecutor) DeleteRemovableFunctions() {
 
 // Return a random function for execution. Returns nil if no active lambda.
 func (l *LambdaExecutor) GetRandomActiveFunction(job *protocol.PanopticJob) *LambdaFunction {
+	return l.GetRandomActiveFunctionExcluding(job, nil)
+}
+
+// Return a random function for execution, preferring functions that are not
+// suspicious and not in excluded. Falls back to any function if all of them
+// are excluded or suspicious. Returns nil if no active lambda.
+func (l *LambdaExecutor) GetRandomActiveFunctionExcluding(job *protocol.PanopticJob, excluded map[string]bool) *LambdaFunction {
 	l.m.Lock()
 	defer l.m.Unlock()
 
@@ -410,9 +498,17 @@ func (l *LambdaExecutor) GetRandomActiveFunction(job *protocol.PanopticJob) *Lam
 		return nil
 	}
 
-	idx := rand.Intn(len(l.pool))
+	candidates := []*LambdaFunction{}
+	for _, f := range l.pool {
+		if !excluded[f.name] && !f.IsSuspicious() {
+			candidates = append(candidates, f)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = l.pool
+	}
 
-	f := l.pool[idx]
+	f := candidates[rand.Intn(len(candidates))]
 
 	// We must register this job when we fetch it, otherwise there might be a case
 	// that when we return this Lambda function, routinely maintainance kicks in
@@ -431,10 +527,61 @@ func (l *LambdaExecutor) Execute(ctx context.Context, job *protocol.PanopticJob)
 		return job, nil
 	}
 
+	maxAttempts := l.config.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultLambdaMaxAttempts
+	}
+	backoff := time.Duration(l.config.RetryBackoffMillisecond) * time.Millisecond
+	if backoff <= 0 {
+		backoff = defaultLambdaRetryBackoff
+	}
+
+	// Functions this job has been invoked on, a retry goes to a different one.
+	// Retrying is idempotent because collected messages are deduplicated by id.
+	tried := make(map[string]bool)
+	for attempt := 1; ; attempt++ {
+		res, f, err := l.executeOnce(ctx, job, tried)
+		if err == nil {
+			return res, nil
+		}
+		// Cancellation is not the function's fault.
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		tried[f.name] = true
+		if isFunctionFault(err) {
+			f.MarkSuspicious()
+		}
+
+		if attempt >= maxAttempts {
+			return nil, fmt.Errorf("job %s failed after %d attempts: %w", job.JobId, attempt, err)
+		}
+		if !l.takeRetryBudget(job) {
+			return nil, fmt.Errorf("job %s failed and retry budget is exhausted: %w", job.JobId, err)
+		}
+		Logger.Log.Warnf("job %s failed on lambda function %s, retry in %s: %v", job.JobId, f.name, backoff, err)
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+
+		// Every attempt crawls the domain again.
+		if err := ChargeDomainBudget(ctx, job); err != nil {
+			return nil, fmt.Errorf("job %s failed and retry is deferred: %w", job.JobId, err)
+		}
+	}
+}
+
+// executeOnce invokes the job on an active function not yet tried, returns
+// the function so that caller can blame it on failure.
+func (l *LambdaExecutor) executeOnce(ctx context.Context, job *protocol.PanopticJob, tried map[string]bool) (*protocol.PanopticJob, *LambdaFunction, error) {
 	// Get a active Lambda function with gracefully retry.
 	var f *LambdaFunction
 	for {
-		f = l.GetRandomActiveFunction(job)
+		f = l.GetRandomActiveFunctionExcluding(job, tried)
 		if f != nil {
 			break
 		}
@@ -442,7 +589,47 @@ func (l *LambdaExecutor) Execute(ctx context.Context, job *protocol.PanopticJob)
 	}
 
 	defer f.DeletePendingJob(job)
-	return MakeDataCollectorRpc(ctx, job, f.name, l.lambdaClient)
+	res, err := l.invoke(ctx, job, f.name)
+	return res, f, err
+}
+
+// takeRetryBudget takes one retry from budget of each config in the job,
+// returns false if any of them has run out of budget.
+func (l *LambdaExecutor) takeRetryBudget(job *protocol.PanopticJob) bool {
+	perHour := l.config.RetryBudgetPerConfigPerHour
+	if perHour <= 0 {
+		perHour = defaultLambdaRetryBudgetPerHour
+	}
+
+	l.budgetM.Lock()
+	defer l.budgetM.Unlock()
+
+	now := time.Now()
+	taken := []*rate.Reservation{}
+	seen := make(map[string]bool)
+	for _, task := range job.Tasks {
+		name := task.GetTaskMetadata().GetConfigName()
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		limiter, ok := l.retryBudgets[name]
+		if !ok {
+			limiter = rate.NewLimiter(rate.Limit(float64(perHour)/3600), perHour)
+			l.retryBudgets[name] = limiter
+		}
+		r := limiter.ReserveN(now, 1)
+		if r.DelayFrom(now) > 0 {
+			r.CancelAt(now)
+			for _, t := range taken {
+				t.CancelAt(now)
+			}
+			return false
+		}
+		taken = append(taken, r)
+	}
+	return true
 }
 
 func (l *LambdaExecutor) Shutdown() {
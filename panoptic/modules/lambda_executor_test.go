@@ -1,11 +1,16 @@
 package modules
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/Luismorlan/newsmux/protocol"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,3 +48,165 @@ func TestFunctionAddDeleteJob(t *testing.T) {
 	f.DeletePendingJob(&protocol.PanopticJob{JobId: "1"})
 	assert.True(t, len(f.jobs) == 0)
 }
+
+func newTestLambdaExecutor(cfg *LambdaExecutorConfig, names ...string) *LambdaExecutor {
+	l := NewLambdaExecutor(context.Background(), nil, cfg)
+	for _, name := range names {
+		l.pool = append(l.pool, &LambdaFunction{
+			name:        name,
+			createdTime: time.Now(),
+			span:        time.Hour,
+			jobs:        make(map[string]*protocol.PanopticJob),
+		})
+	}
+	return l
+}
+
+func newTestLambdaJob(id string, configName string) *protocol.PanopticJob {
+	return &protocol.PanopticJob{
+		JobId: id,
+		Tasks: []*protocol.PanopticTask{{
+			TaskMetadata: &protocol.TaskMetadata{ConfigName: configName},
+		}},
+	}
+}
+
+func TestLambdaExecutorRetryOnDifferentFunction(t *testing.T) {
+	l := newTestLambdaExecutor(&LambdaExecutorConfig{
+		MaxAttempts:             3,
+		RetryBackoffMillisecond: 1,
+	}, "a", "b")
+
+	m := sync.Mutex{}
+	invoked := []string{}
+	l.invoke = func(ctx context.Context, job *protocol.PanopticJob, functionName string) (*protocol.PanopticJob, error) {
+		m.Lock()
+		defer m.Unlock()
+		invoked = append(invoked, functionName)
+		if len(invoked) == 1 {
+			return nil, &types.TooManyRequestsException{}
+		}
+		return job, nil
+	}
+
+	res, err := l.Execute(context.Background(), newTestLambdaJob("1", "config"))
+	assert.Nil(t, err)
+	assert.Equal(t, "1", res.JobId)
+	assert.Len(t, invoked, 2)
+	assert.NotEqual(t, invoked[0], invoked[1])
+
+	for _, f := range l.pool {
+		assert.Equal(t, f.name == invoked[0], f.IsSuspicious())
+		assert.True(t, f.IsRemovable())
+	}
+
+	// Suspicious function is retired on maintenance.
+	l.MarkStaleFunctions()
+	assert.Len(t, l.pool, 1)
+	assert.Equal(t, invoked[1], l.pool[0].name)
+	_, ok := l.stalePool.Load(invoked[0])
+	assert.True(t, ok)
+}
+
+func TestLambdaExecutorCollectorErrorNotBlamedOnFunction(t *testing.T) {
+	l := newTestLambdaExecutor(&LambdaExecutorConfig{
+		MaxAttempts:             3,
+		RetryBackoffMillisecond: 1,
+	}, "a", "b", "c")
+
+	attempts := 0
+	l.invoke = func(ctx context.Context, job *protocol.PanopticJob, functionName string) (*protocol.PanopticJob, error) {
+		attempts++
+		return nil, &CollectorError{Message: "Unhandled"}
+	}
+
+	_, err := l.Execute(context.Background(), newTestLambdaJob("1", "config"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, attempts)
+	for _, f := range l.pool {
+		assert.False(t, f.IsSuspicious())
+	}
+	l.MarkStaleFunctions()
+	assert.Len(t, l.pool, 3)
+}
+
+func TestIsFunctionFault(t *testing.T) {
+	assert.True(t, isFunctionFault(&types.TooManyRequestsException{}))
+	assert.True(t, isFunctionFault(fmt.Errorf("wrapped: %w", &types.ResourceNotFoundException{})))
+	assert.True(t, isFunctionFault(&types.ServiceException{}))
+	assert.False(t, isFunctionFault(&CollectorError{Message: "Unhandled"}))
+	assert.False(t, isFunctionFault(&types.InvalidRequestContentException{}))
+	assert.False(t, isFunctionFault(errors.New("bad payload")))
+}
+
+func TestLambdaExecutorGiveUpAfterMaxAttempts(t *testing.T) {
+	l := newTestLambdaExecutor(&LambdaExecutorConfig{
+		MaxAttempts:             2,
+		RetryBackoffMillisecond: 1,
+	}, "a", "b", "c")
+
+	attempts := 0
+	l.invoke = func(ctx context.Context, job *protocol.PanopticJob, functionName string) (*protocol.PanopticJob, error) {
+		attempts++
+		return nil, errors.New("Unhandled")
+	}
+
+	_, err := l.Execute(context.Background(), newTestLambdaJob("1", "config"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestLambdaExecutorRetryBudgetPerConfig(t *testing.T) {
+	l := newTestLambdaExecutor(&LambdaExecutorConfig{
+		MaxAttempts:                 5,
+		RetryBackoffMillisecond:     1,
+		RetryBudgetPerConfigPerHour: 2,
+	}, "a", "b")
+
+	attempts := make(map[string]int)
+	l.invoke = func(ctx context.Context, job *protocol.PanopticJob, functionName string) (*protocol.PanopticJob, error) {
+		name := job.Tasks[0].TaskMetadata.ConfigName
+		attempts[name]++
+		if name == "broken" {
+			return nil, errors.New("Unhandled")
+		}
+		// Healthy config fails once and succeeds on retry.
+		if attempts[name]%2 == 1 {
+			return nil, errors.New("Unhandled")
+		}
+		return job, nil
+	}
+
+	_, err := l.Execute(context.Background(), newTestLambdaJob("1", "broken"))
+	assert.NotNil(t, err)
+	// First attempt plus 2 retries from budget.
+	assert.Equal(t, 3, attempts["broken"])
+
+	_, err = l.Execute(context.Background(), newTestLambdaJob("2", "broken"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 4, attempts["broken"])
+
+	// Budget of one config doesn't affect others.
+	_, err = l.Execute(context.Background(), newTestLambdaJob("3", "healthy"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, attempts["healthy"])
+}
+
+func TestLambdaExecutorNoRetryOnCancel(t *testing.T) {
+	l := newTestLambdaExecutor(&LambdaExecutorConfig{}, "a", "b")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	l.invoke = func(ctx context.Context, job *protocol.PanopticJob, functionName string) (*protocol.PanopticJob, error) {
+		attempts++
+		cancel()
+		return nil, ctx.Err()
+	}
+
+	_, err := l.Execute(ctx, newTestLambdaJob("1", "config"))
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, attempts)
+	for _, f := range l.pool {
+		assert.False(t, f.IsSuspicious())
+	}
+}
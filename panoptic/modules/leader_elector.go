@@ -0,0 +1,224 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/panoptic"
+	Logger "github.com/Luismorlan/newsmux/utils/log"
+)
+
+const (
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewInterval = 3 * time.Second
+)
+
+type LeaderElectorConfig struct {
+	Name string
+
+	// Name of the lease row, instances competing for the same lease elect one
+	// leader.
+	LeaseName string
+
+	// Unique id of this instance, defaults to hostname plus a random suffix.
+	Holder string
+
+	// How long a lease lasts without renewal, which is also how long followers
+	// wait to take over from a dead leader.
+	LeaseDuration time.Duration
+
+	// How often the leader renews and followers try to acquire the lease, must
+	// be shorter than LeaseDuration.
+	RenewInterval time.Duration
+}
+
+// LeaderElector elects a leader among panoptic instances sharing a database,
+// using a lease row that the leader renews periodically. Time is compared on
+// DB so that instances don't need synchronized clocks.
+//
+// A leader steps down on its own once it fails to renew the lease before it
+// expires, so that two instances never lead at the same time as long as the
+// lease is renewed well within RenewInterval.
+type LeaderElector struct {
+	panoptic.Module
+
+	Config LeaderElectorConfig
+
+	DB *gorm.DB
+
+	m sync.Mutex
+
+	// Local deadline of the lease, conservatively measured from before the
+	// lease is renewed.
+	leaseUntil time.Time
+
+	// Non-nil while leading, cancelled exactly when stepping down.
+	leaderCtx    context.Context
+	leaderCancel context.CancelFunc
+
+	// Closed when this instance becomes leader, replaced on stepping down.
+	elected chan struct{}
+}
+
+func NewLeaderElector(config LeaderElectorConfig, db *gorm.DB) (*LeaderElector, error) {
+	if config.LeaseName == "" {
+		config.LeaseName = "scheduler"
+	}
+	if config.Holder == "" {
+		hostname, _ := os.Hostname()
+		config.Holder = fmt.Sprintf("%s-%s", hostname, uuid.NewString()[:8])
+	}
+	if config.LeaseDuration <= 0 {
+		config.LeaseDuration = defaultLeaseDuration
+	}
+	if config.RenewInterval <= 0 {
+		config.RenewInterval = defaultRenewInterval
+	}
+	if config.RenewInterval >= config.LeaseDuration {
+		return nil, errors.New("lease renew interval must be shorter than lease duration")
+	}
+	return &LeaderElector{
+		Config:  config,
+		DB:      db,
+		elected: make(chan struct{}),
+	}, nil
+}
+
+// tryAcquire takes the lease if it's free or expired, or renews it if it's
+// already held by this instance. Returns whether the lease is held.
+func (e *LeaderElector) tryAcquire(ctx context.Context) (bool, error) {
+	// A hanging attempt must not keep a leader leading past its lease.
+	ctx, cancel := context.WithTimeout(ctx, e.Config.RenewInterval)
+	defer cancel()
+
+	res := e.DB.WithContext(ctx).Exec(`INSERT INTO panoptic_leader_leases (name, holder, expires_at)
+		VALUES (?, ?, NOW() + ? * INTERVAL '1 millisecond')
+		ON CONFLICT (name) DO UPDATE SET holder = EXCLUDED.holder, expires_at = EXCLUDED.expires_at
+		WHERE panoptic_leader_leases.holder = EXCLUDED.holder
+			OR panoptic_leader_leases.expires_at < NOW()`,
+		e.Config.LeaseName, e.Config.Holder, e.Config.LeaseDuration.Milliseconds())
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+// release gives up the lease so that a follower takes over without waiting
+// for it to expire.
+func (e *LeaderElector) release() error {
+	return e.DB.Where("name = ? AND holder = ?", e.Config.LeaseName, e.Config.Holder).
+		Delete(&model.PanopticLeaderLease{}).Error
+}
+
+// campaign tries to acquire or renew the lease once and updates leadership.
+func (e *LeaderElector) campaign(ctx context.Context) {
+	start := time.Now()
+	held, err := e.tryAcquire(ctx)
+
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if err != nil {
+		Logger.Log.Errorf("fail to acquire leader lease %s: %v", e.Config.LeaseName, err)
+		// Keep leading only if the lease surely outlives the next attempt.
+		if e.leaderCtx != nil && time.Until(e.leaseUntil) < e.Config.RenewInterval {
+			e.stepDown()
+		}
+		return
+	}
+	if !held {
+		if e.leaderCtx != nil {
+			e.stepDown()
+		}
+		return
+	}
+
+	e.leaseUntil = start.Add(e.Config.LeaseDuration)
+	if e.leaderCtx == nil {
+		Logger.Log.Infof("%s becomes leader of %s", e.Config.Holder, e.Config.LeaseName)
+		e.leaderCtx, e.leaderCancel = context.WithCancel(context.Background())
+		close(e.elected)
+	}
+}
+
+// Caller must hold the lock.
+func (e *LeaderElector) stepDown() {
+	Logger.Log.Warnf("%s steps down as leader of %s", e.Config.Holder, e.Config.LeaseName)
+	e.leaderCancel()
+	e.leaderCtx = nil
+	e.leaderCancel = nil
+	e.elected = make(chan struct{})
+}
+
+// IsLeader returns whether this instance currently holds the lease.
+func (e *LeaderElector) IsLeader() bool {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	return e.leaderCtx != nil && time.Now().Before(e.leaseUntil)
+}
+
+// WaitForLeadership blocks until this instance becomes leader, and returns a
+// context which is cancelled once it steps down.
+func (e *LeaderElector) WaitForLeadership(ctx context.Context) (context.Context, error) {
+	for {
+		e.m.Lock()
+		leaderCtx, elected := e.leaderCtx, e.elected
+		e.m.Unlock()
+
+		if leaderCtx != nil && leaderCtx.Err() == nil {
+			return leaderCtx, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-elected:
+		}
+	}
+}
+
+func (e *LeaderElector) RunModule(ctx context.Context) error {
+	ticker := time.NewTicker(e.Config.RenewInterval)
+	defer ticker.Stop()
+
+	for {
+		e.campaign(ctx)
+		select {
+		case <-ctx.Done():
+			// Lease is released on shutdown, if the process dies instead it
+			// expires after LeaseDuration.
+			e.m.Lock()
+			if e.leaderCtx != nil {
+				e.stepDown()
+			}
+			e.m.Unlock()
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *LeaderElector) Name() string {
+	return e.Config.Name
+}
+
+func (e *LeaderElector) Shutdown() {
+	e.m.Lock()
+	if e.leaderCtx != nil {
+		e.stepDown()
+	}
+	e.m.Unlock()
+
+	if err := e.release(); err != nil {
+		Logger.Log.Errorf("fail to release leader lease %s: %v", e.Config.LeaseName, err)
+	}
+	Logger.Log.Infoln("Module ", e.Config.Name, " gracefully shutdown")
+}
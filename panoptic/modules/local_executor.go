@@ -0,0 +1,136 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	collector_handler "github.com/Luismorlan/newsmux/collector/handler"
+	"github.com/Luismorlan/newsmux/protocol"
+)
+
+const defaultLocalExecutorPoolSize = 4
+
+// Configuration of the local executor.
+type LocalExecutorConfig struct {
+	// Max number of jobs executing at the same time, further jobs wait for a
+	// free worker.
+	WorkerPoolSize int
+
+	// Timeout of a single job execution. No timeout if zero.
+	JobTimeout time.Duration
+
+	// If set, each job is executed in a subprocess started by this command,
+	// which reads a serialized PanopticJob from stdin and writes the executed
+	// job to stdout, e.g. ["./collector", "-mode=stdin"]. Otherwise jobs are
+	// executed in this process.
+	SubprocessCommand []string
+}
+
+// LocalExecutor executes jobs on this machine instead of AWS Lambda, so that
+// panoptic can run without AWS for development and self-hosting.
+type LocalExecutor struct {
+	config *LocalExecutorConfig
+
+	// Each running job holds a slot until its collection actually returns.
+	workers chan struct{}
+
+	// Executes a job, returns the job with execution result.
+	collect func(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error)
+}
+
+func NewLocalExecutor(cfg *LocalExecutorConfig) *LocalExecutor {
+	size := cfg.WorkerPoolSize
+	if size <= 0 {
+		size = defaultLocalExecutorPoolSize
+	}
+	e := &LocalExecutor{
+		config:  cfg,
+		workers: make(chan struct{}, size),
+	}
+	if len(cfg.SubprocessCommand) > 0 {
+		e.collect = e.collectInSubprocess
+	} else {
+		e.collect = collectInProcess
+	}
+	return e
+}
+
+// Collector doesn't support cancellation, a cancelled in-process collection
+// keeps running in background until it returns, and keeps holding its
+// worker. Use subprocess to kill timed out collections.
+func collectInProcess(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+	res := proto.Clone(job).(*protocol.PanopticJob)
+	if err := (collector_handler.DataCollectJobHandler{}).Collect(res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (e *LocalExecutor) collectInSubprocess(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+	in, err := proto.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+
+	// Subprocess is killed once ctx is done.
+	cmd := exec.CommandContext(ctx, e.config.SubprocessCommand[0], e.config.SubprocessCommand[1:]...)
+	var out bytes.Buffer
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stdout = &out
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("collector subprocess failed: %w", err)
+	}
+
+	res := &protocol.PanopticJob{}
+	if err := proto.Unmarshal(out.Bytes(), res); err != nil {
+		return nil, fmt.Errorf("fail to parse collector subprocess output: %w", err)
+	}
+	return res, nil
+}
+
+// Execute is a blocking call that executes a single PanopticJob locally. It
+// returns the job with additional metadata describing the execution result.
+func (e *LocalExecutor) Execute(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+	select {
+	case e.workers <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if e.config.JobTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.config.JobTimeout)
+		defer cancel()
+	}
+
+	type result struct {
+		job *protocol.PanopticJob
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		defer func() { <-e.workers }()
+		res, err := e.collect(ctx, job)
+		done <- result{job: res, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("job %s timed out after %s", job.JobId, e.config.JobTimeout)
+		}
+		return nil, ctx.Err()
+	case res := <-done:
+		return res.job, res.err
+	}
+}
+
+func (e *LocalExecutor) Shutdown() {}
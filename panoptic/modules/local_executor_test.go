@@ -0,0 +1,98 @@
+package modules
+
+import (
+	"context"
+	"os/exec"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Luismorlan/newsmux/protocol"
+)
+
+func newTestLocalExecutor(cfg *LocalExecutorConfig, collect func(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error)) *LocalExecutor {
+	e := NewLocalExecutor(cfg)
+	e.collect = collect
+	return e
+}
+
+func TestLocalExecutor_BoundedWorkerPool(t *testing.T) {
+	var running, maxRunning int32
+	e := newTestLocalExecutor(&LocalExecutorConfig{WorkerPoolSize: 2}, func(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return job, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := e.Execute(context.Background(), &protocol.PanopticJob{JobId: "job"})
+			assert.NoError(t, err)
+			assert.Equal(t, "job", res.JobId)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(2), maxRunning)
+}
+
+func TestLocalExecutor_Timeout(t *testing.T) {
+	e := newTestLocalExecutor(&LocalExecutorConfig{JobTimeout: 10 * time.Millisecond}, func(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	_, err := e.Execute(context.Background(), &protocol.PanopticJob{JobId: "job"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out")
+}
+
+func TestLocalExecutor_Cancel(t *testing.T) {
+	e := newTestLocalExecutor(&LocalExecutorConfig{WorkerPoolSize: 1}, func(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	_, err := e.Execute(ctx, &protocol.PanopticJob{JobId: "job"})
+	assert.Equal(t, context.Canceled, err)
+
+	// Waiting for a worker is cancelled as well.
+	_, err = e.Execute(ctx, &protocol.PanopticJob{JobId: "job"})
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestLocalExecutor_Subprocess(t *testing.T) {
+	cat, err := exec.LookPath("cat")
+	if err != nil {
+		t.Skip("cat is not available")
+	}
+
+	// cat echos the serialized job back.
+	e := NewLocalExecutor(&LocalExecutorConfig{SubprocessCommand: []string{cat}})
+	job := &protocol.PanopticJob{JobId: "job", Tasks: []*protocol.PanopticTask{{TaskId: "task"}}}
+	res, err := e.Execute(context.Background(), job)
+	require.NoError(t, err)
+	assert.Equal(t, "task", res.Tasks[0].TaskId)
+
+	e = NewLocalExecutor(&LocalExecutorConfig{SubprocessCommand: []string{"false"}})
+	_, err = e.Execute(context.Background(), job)
+	assert.Error(t, err)
+}
@@ -2,19 +2,25 @@ package modules
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/Luismorlan/newsmux/panoptic"
 	"github.com/Luismorlan/newsmux/protocol"
 	Logger "github.com/Luismorlan/newsmux/utils/log"
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill/message"
-	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type OrchestratorConfig struct {
 	// Name of the orchestrator.
 	Name string
+
+	// Number of pending job partitions, must be the same as scheduler job
+	// doer's. Jobs of different partitions are executed concurrently.
+	Partitions int
 }
 
 type Orchestrator struct {
@@ -24,11 +30,11 @@ type Orchestrator struct {
 
 	executor Executor
 
-	EventBus *gochannel.GoChannel
+	EventBus panoptic.EventBus
 }
 
 // Return a new instance of Orchestrator.
-func NewOrchestrator(config OrchestratorConfig, executor Executor, e *gochannel.GoChannel) *Orchestrator {
+func NewOrchestrator(config OrchestratorConfig, executor Executor, e panoptic.EventBus) *Orchestrator {
 	return &Orchestrator{
 		Config:   config,
 		executor: executor,
@@ -47,41 +53,93 @@ func (o *Orchestrator) PublishFinishedJob(job *protocol.PanopticJob) error {
 	return o.EventBus.Publish(panoptic.TopicExecutedJob, msg)
 }
 
-func (o *Orchestrator) RunModule(ctx context.Context) error {
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
+// DeferJob marks all tasks of a job not executed for lack of domain budget as
+// deferred, scheduler executes the config again after retryAfter.
+func DeferJob(job *protocol.PanopticJob, retryAfter time.Duration) *protocol.PanopticJob {
+	now := timestamppb.Now()
+	for _, task := range job.Tasks {
+		if task.TaskMetadata == nil {
+			task.TaskMetadata = &protocol.TaskMetadata{}
+		}
+		task.TaskMetadata.ResultState = protocol.TaskMetadata_STATE_DEFERRED
+		task.TaskMetadata.RetryAfterMilliseconds = retryAfter.Milliseconds()
+		task.TaskMetadata.TaskStartTime = now
+		task.TaskMetadata.TaskEndTime = now
+	}
+	return job
+}
 
-	messages, err := o.EventBus.Subscribe(ctx, panoptic.TopicPendingJob)
+// Execute pending jobs of a partition one by one. A job is acked after it's
+// executed and the result is published, so that it's executed again if
+// panoptic restarts in between. A job interrupted by shutdown is nacked.
+func (o *Orchestrator) ProcessPartition(ctx context.Context, partition int) error {
+	messages, err := o.EventBus.Subscribe(ctx, panoptic.PendingJobTopic(partition), o.Config.Name)
 	if err != nil {
 		return err
 	}
 
 	for msg := range messages {
-		msg.Ack()
-
 		panopticJob := protocol.PanopticJob{}
-		err := proto.Unmarshal(msg.Payload, &panopticJob)
+		if err := proto.Unmarshal(msg.Payload, &panopticJob); err != nil {
+			Logger.Log.Errorf("fail to unmarshal pending job, dropped: %s", err)
+			msg.Ack()
+			continue
+		}
 
+		res, err := o.executor.Execute(ctx, &panopticJob)
+		if err != nil && ctx.Err() != nil {
+			// Interrupted by shutdown rather than failed, leave the job to be
+			// executed again after restart.
+			Logger.Log.Infof("job %s interrupted by shutdown, will be executed again", panopticJob.JobId)
+			msg.Nack()
+			return nil
+		}
+		var overBudget *OverBudgetError
+		if errors.As(err, &overBudget) {
+			// Already logged by budgeted executor. Deferred job is reported
+			// as executed so that it's recorded and rescheduled by scheduler.
+			res, err = DeferJob(&panopticJob, overBudget.RetryAfter), nil
+		}
 		if err != nil {
-			return err
+			// The config will be scheduled again, no need to retry.
+			Logger.Log.Errorf("fail to execute job: %s, error: %s", panopticJob.String(), err)
+			msg.Ack()
+			continue
 		}
 
-		go func(job *protocol.PanopticJob) {
-			res, err := o.executor.Execute(ctx, &panopticJob)
-			if err != nil {
-				Logger.Log.Errorf("fail to execute job: %s, error: %s", panopticJob.String(), err)
-				return
-			}
+		if err := o.PublishFinishedJob(res); err != nil {
+			Logger.Log.Errorf("fail to publish job into executed job channel, error: %s", err)
+			msg.Nack()
+			continue
+		}
+		msg.Ack()
+	}
 
-			err = o.PublishFinishedJob(res)
-			if err != nil {
-				Logger.Log.Errorf("fail to publish job into executed job channel, error: %s", err)
-				return
-			}
-		}(&panopticJob)
+	return nil
+}
 
+func (o *Orchestrator) RunModule(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	partitions := o.Config.Partitions
+	if partitions <= 0 {
+		partitions = 1
+	}
+
+	errs := make(chan error, partitions)
+	for partition := 0; partition < partitions; partition++ {
+		go func(partition int) {
+			errs <- o.ProcessPartition(ctx, partition)
+		}(partition)
 	}
 
+	// Restart all partitions if any of them fails.
+	for partition := 0; partition < partitions; partition++ {
+		if err := <-errs; err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
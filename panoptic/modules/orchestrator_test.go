@@ -0,0 +1,227 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Luismorlan/newsmux/panoptic"
+	"github.com/Luismorlan/newsmux/protocol"
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeExecutor struct {
+	executed chan *protocol.PanopticJob
+	err      error
+}
+
+func (e *fakeExecutor) Execute(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+	e.executed <- job
+	return job, e.err
+}
+
+func (e *fakeExecutor) Shutdown() {}
+
+// blockingExecutor executes a job until the context is cancelled.
+type blockingExecutor struct {
+	started chan *protocol.PanopticJob
+}
+
+func (e *blockingExecutor) Execute(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+	e.started <- job
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (e *blockingExecutor) Shutdown() {}
+
+// redeliveringEventBus is a single topic in-memory EventBus which keeps
+// messages until they are acked, like a persistent event bus does across
+// restarts.
+type redeliveringEventBus struct {
+	pending chan *message.Message
+}
+
+func (b *redeliveringEventBus) Publish(topic string, messages ...*message.Message) error {
+	for _, msg := range messages {
+		b.pending <- msg
+	}
+	return nil
+}
+
+func (b *redeliveringEventBus) Subscribe(ctx context.Context, topic string, consumerGroup string) (<-chan *message.Message, error) {
+	out := make(chan *message.Message)
+	go func() {
+		defer close(out)
+		for {
+			var msg *message.Message
+			select {
+			case <-ctx.Done():
+				return
+			case msg = <-b.pending:
+			}
+			select {
+			case out <- msg:
+			case <-ctx.Done():
+				b.pending <- msg
+				return
+			}
+			select {
+			case <-msg.Acked():
+			case <-msg.Nacked():
+				b.pending <- msg.Copy()
+			}
+		}
+	}()
+	return out, nil
+}
+
+func (b *redeliveringEventBus) Close() error {
+	return nil
+}
+
+func publishPendingJob(t *testing.T, eventbus panoptic.EventBus, partition int, jobId string) {
+	data, err := proto.Marshal(&protocol.PanopticJob{JobId: jobId})
+	assert.Nil(t, err)
+	assert.Nil(t, eventbus.Publish(panoptic.PendingJobTopic(partition), message.NewMessage(watermill.NewUUID(), data)))
+}
+
+func TestOrchestratorExecutesPartitions(t *testing.T) {
+	eventbus := panoptic.NewGoChannelEventBus(watermill.NewStdLogger(false, false))
+	executor := &fakeExecutor{executed: make(chan *protocol.PanopticJob, 10)}
+	orchestrator := NewOrchestrator(OrchestratorConfig{Name: "orchestrator", Partitions: 2}, executor, eventbus)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	executed, err := eventbus.Subscribe(ctx, panoptic.TopicExecutedJob, "reporter")
+	assert.Nil(t, err)
+
+	go orchestrator.RunModule(ctx)
+	// Wait for partitions to subscribe, gochannel drops messages without
+	// subscriber.
+	time.Sleep(100 * time.Millisecond)
+
+	publishPendingJob(t, eventbus, 0, "job_0")
+	publishPendingJob(t, eventbus, 1, "job_1")
+
+	received := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		select {
+		case msg := <-executed:
+			job := protocol.PanopticJob{}
+			assert.Nil(t, proto.Unmarshal(msg.Payload, &job))
+			received[job.JobId] = true
+			msg.Ack()
+		case <-time.After(5 * time.Second):
+			t.Fatal("executed job is not published")
+		}
+	}
+	assert.Equal(t, map[string]bool{"job_0": true, "job_1": true}, received)
+}
+
+func TestOrchestratorAcksFailedJob(t *testing.T) {
+	eventbus := panoptic.NewGoChannelEventBus(watermill.NewStdLogger(false, false))
+	executor := &fakeExecutor{executed: make(chan *protocol.PanopticJob, 10), err: errors.New("timeout")}
+	orchestrator := NewOrchestrator(OrchestratorConfig{Name: "orchestrator", Partitions: 1}, executor, eventbus)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go orchestrator.RunModule(ctx)
+	time.Sleep(100 * time.Millisecond)
+
+	// The second job is only delivered after the first one is acked.
+	publishPendingJob(t, eventbus, 0, "job_0")
+	publishPendingJob(t, eventbus, 0, "job_1")
+	executed := map[string]bool{}
+	for i := 0; i < 2; i++ {
+		select {
+		case job := <-executor.executed:
+			executed[job.JobId] = true
+		case <-time.After(5 * time.Second):
+			t.Fatal("pending job is not executed")
+		}
+	}
+	assert.Equal(t, map[string]bool{"job_0": true, "job_1": true}, executed)
+}
+
+func TestOrchestratorRedeliversJobInterruptedByShutdown(t *testing.T) {
+	eventbus := &redeliveringEventBus{pending: make(chan *message.Message, 10)}
+	blocking := &blockingExecutor{started: make(chan *protocol.PanopticJob, 10)}
+	orchestrator := NewOrchestrator(OrchestratorConfig{Name: "orchestrator", Partitions: 1}, blocking, eventbus)
+
+	publishPendingJob(t, eventbus, 0, "job_0")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- orchestrator.ProcessPartition(ctx, 0)
+	}()
+	select {
+	case job := <-blocking.started:
+		assert.Equal(t, "job_0", job.JobId)
+	case <-time.After(5 * time.Second):
+		t.Fatal("pending job is not executed")
+	}
+	cancel()
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("partition is not stopped on shutdown")
+	}
+
+	// After restart the interrupted job is executed again.
+	executor := &fakeExecutor{executed: make(chan *protocol.PanopticJob, 10)}
+	orchestrator = NewOrchestrator(OrchestratorConfig{Name: "orchestrator", Partitions: 1}, executor, eventbus)
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	go orchestrator.ProcessPartition(ctx, 0)
+	select {
+	case job := <-executor.executed:
+		assert.Equal(t, "job_0", job.JobId)
+	case <-time.After(5 * time.Second):
+		t.Fatal("interrupted job is not delivered again")
+	}
+}
+
+func TestOrchestratorPublishesDeferredJob(t *testing.T) {
+	eventbus := panoptic.NewGoChannelEventBus(watermill.NewStdLogger(false, false))
+	executor := &fakeExecutor{
+		executed: make(chan *protocol.PanopticJob, 10),
+		err:      &OverBudgetError{Domain: "weibo.com", RetryAfter: 5 * time.Second},
+	}
+	orchestrator := NewOrchestrator(OrchestratorConfig{Name: "orchestrator", Partitions: 1}, executor, eventbus)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	executed, err := eventbus.Subscribe(ctx, panoptic.TopicExecutedJob, "reporter")
+	assert.Nil(t, err)
+
+	go orchestrator.RunModule(ctx)
+	time.Sleep(100 * time.Millisecond)
+
+	data, err := proto.Marshal(&protocol.PanopticJob{
+		JobId: "job_0",
+		Tasks: []*protocol.PanopticTask{{TaskId: "task_0", TaskMetadata: &protocol.TaskMetadata{ConfigName: "cfg"}}},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, eventbus.Publish(panoptic.PendingJobTopic(0), message.NewMessage(watermill.NewUUID(), data)))
+
+	select {
+	case msg := <-executed:
+		job := protocol.PanopticJob{}
+		assert.Nil(t, proto.Unmarshal(msg.Payload, &job))
+		msg.Ack()
+		meta := job.Tasks[0].TaskMetadata
+		assert.Equal(t, protocol.TaskMetadata_STATE_DEFERRED, meta.ResultState)
+		assert.Equal(t, int64(5000), meta.RetryAfterMilliseconds)
+		assert.NotNil(t, meta.TaskStartTime)
+	case <-time.After(5 * time.Second):
+		t.Fatal("deferred job is not published")
+	}
+}
@@ -0,0 +1,311 @@
+package modules
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Luismorlan/newsmux/panoptic"
+	"github.com/Luismorlan/newsmux/protocol"
+	Logger "github.com/Luismorlan/newsmux/utils/log"
+)
+
+const (
+	defaultRemoteHealthCheckInterval = 30 * time.Second
+	defaultRemoteRequestTimeout      = 10 * time.Minute
+	remoteHealthCheckTimeout         = 5 * time.Second
+)
+
+var ErrNoHealthyWorker = errors.New("no healthy remote collector worker")
+
+// Configuration of the remote http executor.
+type RemoteHttpExecutorConfig struct {
+	// Base urls of collector workers, e.g. "http://203.0.113.7:8080".
+	Workers []string
+
+	// Check health of all workers every other interval.
+	HealthCheckInterval time.Duration
+
+	// Timeout of a single job execution on a worker.
+	RequestTimeout time.Duration
+
+	// How many different workers a job is tried on before giving up. Defaults
+	// to all workers.
+	MaxAttempts int
+
+	// Shared secret sent to workers as bearer token.
+	Token string
+}
+
+// RemoteWorker is a long-lived collector worker serving cmd/collector in http
+// mode.
+type RemoteWorker struct {
+	url string
+	// Recorded as TaskMetadata.IpAddr of tasks executed on this worker.
+	host string
+
+	m        sync.RWMutex
+	healthy  bool
+	inflight int
+}
+
+func (w *RemoteWorker) IsHealthy() bool {
+	w.m.RLock()
+	defer w.m.RUnlock()
+	return w.healthy
+}
+
+func (w *RemoteWorker) setHealthy(healthy bool) {
+	w.m.Lock()
+	defer w.m.Unlock()
+	if w.healthy != healthy {
+		Logger.Log.Infof("remote collector worker %s healthy: %t", w.url, healthy)
+	}
+	w.healthy = healthy
+}
+
+// RemoteHttpExecutor executes jobs on our own collector workers over http,
+// which is useful for sources blocking cloud IP ranges. Jobs are balanced to
+// the healthy worker with least in-flight jobs, and retried on another worker
+// on failure.
+type RemoteHttpExecutor struct {
+	config *RemoteHttpExecutorConfig
+
+	ctx context.Context
+
+	client *http.Client
+
+	m       sync.RWMutex
+	workers []*RemoteWorker
+}
+
+func NewRemoteHttpExecutor(ctx context.Context, cfg *RemoteHttpExecutorConfig) *RemoteHttpExecutor {
+	if cfg.Token == "" {
+		cfg.Token = os.Getenv(panoptic.RemoteCollectorTokenEnv)
+	}
+	return &RemoteHttpExecutor{
+		config: cfg,
+		ctx:    ctx,
+		client: &http.Client{},
+	}
+}
+
+// Init registers configured workers, checks their health and keeps checking
+// in background.
+func (e *RemoteHttpExecutor) Init() error {
+	for _, u := range e.config.Workers {
+		if err := e.RegisterWorker(u); err != nil {
+			return err
+		}
+	}
+	e.CheckWorkers()
+
+	interval := e.config.HealthCheckInterval
+	if interval <= 0 {
+		interval = defaultRemoteHealthCheckInterval
+	}
+	go func() {
+		for {
+			select {
+			case <-e.ctx.Done():
+				return
+			case <-time.After(interval):
+				e.CheckWorkers()
+			}
+		}
+	}()
+	return nil
+}
+
+// RegisterWorker adds a worker, it's considered healthy until a health check
+// says otherwise.
+func (e *RemoteHttpExecutor) RegisterWorker(workerUrl string) error {
+	u, err := url.Parse(workerUrl)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("invalid remote collector worker url: %s", workerUrl)
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+	for _, w := range e.workers {
+		if w.url == workerUrl {
+			return nil
+		}
+	}
+	e.workers = append(e.workers, &RemoteWorker{url: workerUrl, host: u.Hostname(), healthy: true})
+	return nil
+}
+
+func (e *RemoteHttpExecutor) DeregisterWorker(workerUrl string) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	for idx, w := range e.workers {
+		if w.url == workerUrl {
+			e.workers = append(e.workers[:idx], e.workers[idx+1:]...)
+			return
+		}
+	}
+}
+
+// CheckWorkers checks health of all workers concurrently.
+func (e *RemoteHttpExecutor) CheckWorkers() {
+	e.m.RLock()
+	workers := append([]*RemoteWorker{}, e.workers...)
+	e.m.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, w := range workers {
+		wg.Add(1)
+		go func(w *RemoteWorker) {
+			defer wg.Done()
+			w.setHealthy(e.checkWorker(w) == nil)
+		}(w)
+	}
+	wg.Wait()
+}
+
+func (e *RemoteHttpExecutor) checkWorker(w *RemoteWorker) error {
+	ctx, cancel := context.WithTimeout(e.ctx, remoteHealthCheckTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, w.url+panoptic.RemoteCollectorHealthPath, nil)
+	if err != nil {
+		return err
+	}
+	res, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("health check http code %d", res.StatusCode)
+	}
+	return nil
+}
+
+// Pick the healthy worker with least in-flight jobs that is not tried yet,
+// and count the job as in-flight on it. Returns nil if there's none.
+func (e *RemoteHttpExecutor) acquireWorker(tried map[*RemoteWorker]bool) *RemoteWorker {
+	e.m.RLock()
+	defer e.m.RUnlock()
+
+	var best *RemoteWorker
+	bestInflight := 0
+	for _, w := range e.workers {
+		if tried[w] {
+			continue
+		}
+		w.m.RLock()
+		healthy, inflight := w.healthy, w.inflight
+		w.m.RUnlock()
+		if healthy && (best == nil || inflight < bestInflight) {
+			best, bestInflight = w, inflight
+		}
+	}
+	if best != nil {
+		best.m.Lock()
+		best.inflight++
+		best.m.Unlock()
+	}
+	return best
+}
+
+func (w *RemoteWorker) release() {
+	w.m.Lock()
+	defer w.m.Unlock()
+	w.inflight--
+}
+
+func (e *RemoteHttpExecutor) executeOnWorker(ctx context.Context, w *RemoteWorker, payload []byte) (*protocol.PanopticJob, error) {
+	timeout := e.config.RequestTimeout
+	if timeout <= 0 {
+		timeout = defaultRemoteRequestTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url+panoptic.RemoteCollectorCollectPath, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	if e.config.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+e.config.Token)
+	}
+
+	res, err := e.client.Do(req)
+	if err != nil {
+		// Worker is unreachable, stop sending jobs until it passes a health
+		// check again.
+		if ctx.Err() == nil {
+			w.setHealthy(false)
+		}
+		return nil, err
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("http code %d: %s", res.StatusCode, string(body))
+	}
+
+	job := &protocol.PanopticJob{}
+	if err := proto.Unmarshal(body, job); err != nil {
+		return nil, err
+	}
+	for _, task := range job.Tasks {
+		if task.TaskMetadata == nil {
+			task.TaskMetadata = &protocol.TaskMetadata{}
+		}
+		task.TaskMetadata.IpAddr = w.host
+	}
+	return job, nil
+}
+
+// Execute is a blocking call that executes a single PanopticJob on a remote
+// worker, retrying on other workers on failure. It returns the job with
+// additional metadata describing the execution result.
+func (e *RemoteHttpExecutor) Execute(ctx context.Context, job *protocol.PanopticJob) (*protocol.PanopticJob, error) {
+	payload, err := proto.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAttempts := e.config.MaxAttempts
+	tried := map[*RemoteWorker]bool{}
+	var lastErr error = ErrNoHealthyWorker
+	for attempt := 0; maxAttempts <= 0 || attempt < maxAttempts; attempt++ {
+		w := e.acquireWorker(tried)
+		if w == nil {
+			break
+		}
+		tried[w] = true
+
+		res, err := e.executeOnWorker(ctx, w, payload)
+		w.release()
+		if err == nil {
+			return res, nil
+		}
+		Logger.Log.Errorf("fail to execute job %s on remote worker %s: %v", job.JobId, w.url, err)
+		lastErr = err
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+	}
+	return nil, fmt.Errorf("fail to execute job %s on %d remote workers: %w", job.JobId, len(tried), lastErr)
+}
+
+func (e *RemoteHttpExecutor) Shutdown() {}
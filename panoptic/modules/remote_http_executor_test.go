@@ -0,0 +1,138 @@
+package modules
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Luismorlan/newsmux/panoptic"
+	"github.com/Luismorlan/newsmux/protocol"
+)
+
+// Fake collector worker, marks all tasks succeeded unless failing.
+type fakeCollectorWorker struct {
+	server    *httptest.Server
+	failing   int32
+	unhealthy int32
+	collected int32
+}
+
+func newFakeCollectorWorker(t *testing.T) *fakeCollectorWorker {
+	w := &fakeCollectorWorker{}
+	mux := http.NewServeMux()
+	mux.HandleFunc(panoptic.RemoteCollectorHealthPath, func(rw http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&w.unhealthy) == 1 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+	mux.HandleFunc(panoptic.RemoteCollectorCollectPath, func(rw http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if atomic.LoadInt32(&w.failing) == 1 {
+			rw.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		atomic.AddInt32(&w.collected, 1)
+		in, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		job := &protocol.PanopticJob{}
+		require.NoError(t, proto.Unmarshal(in, job))
+		for _, task := range job.Tasks {
+			task.TaskMetadata = &protocol.TaskMetadata{ResultState: protocol.TaskMetadata_STATE_SUCCESS}
+		}
+		out, err := proto.Marshal(job)
+		require.NoError(t, err)
+		rw.Write(out)
+	})
+	w.server = httptest.NewServer(mux)
+	t.Cleanup(w.server.Close)
+	return w
+}
+
+func newTestRemoteHttpExecutor(t *testing.T, workers ...*fakeCollectorWorker) *RemoteHttpExecutor {
+	urls := []string{}
+	for _, w := range workers {
+		urls = append(urls, w.server.URL)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	e := NewRemoteHttpExecutor(ctx, &RemoteHttpExecutorConfig{Workers: urls, Token: "secret"})
+	require.NoError(t, e.Init())
+	return e
+}
+
+func testRemoteJob() *protocol.PanopticJob {
+	return &protocol.PanopticJob{JobId: "job", Tasks: []*protocol.PanopticTask{{TaskId: "task"}}}
+}
+
+func TestRemoteHttpExecutor_Execute(t *testing.T) {
+	worker := newFakeCollectorWorker(t)
+	e := newTestRemoteHttpExecutor(t, worker)
+
+	res, err := e.Execute(context.Background(), testRemoteJob())
+	require.NoError(t, err)
+	assert.Equal(t, protocol.TaskMetadata_STATE_SUCCESS, res.Tasks[0].TaskMetadata.ResultState)
+	assert.Equal(t, "127.0.0.1", res.Tasks[0].TaskMetadata.IpAddr)
+}
+
+func TestRemoteHttpExecutor_RetryOnAnotherWorker(t *testing.T) {
+	failing, healthy := newFakeCollectorWorker(t), newFakeCollectorWorker(t)
+	failing.failing = 1
+	e := newTestRemoteHttpExecutor(t, failing, healthy)
+
+	for i := 0; i < 4; i++ {
+		_, err := e.Execute(context.Background(), testRemoteJob())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(4), healthy.collected)
+}
+
+func TestRemoteHttpExecutor_SkipUnhealthyWorker(t *testing.T) {
+	unhealthy, healthy := newFakeCollectorWorker(t), newFakeCollectorWorker(t)
+	unhealthy.unhealthy = 1
+	e := newTestRemoteHttpExecutor(t, unhealthy, healthy)
+
+	for i := 0; i < 4; i++ {
+		_, err := e.Execute(context.Background(), testRemoteJob())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(0), unhealthy.collected)
+	assert.Equal(t, int32(4), healthy.collected)
+
+	// Recovered worker gets jobs after next health check.
+	unhealthy.unhealthy = 0
+	e.CheckWorkers()
+	assert.True(t, e.workers[0].IsHealthy())
+}
+
+func TestRemoteHttpExecutor_UnreachableWorker(t *testing.T) {
+	worker := newFakeCollectorWorker(t)
+	e := newTestRemoteHttpExecutor(t, worker)
+	worker.server.Close()
+
+	_, err := e.Execute(context.Background(), testRemoteJob())
+	assert.Error(t, err)
+	assert.False(t, e.workers[0].IsHealthy())
+
+	_, err = e.Execute(context.Background(), testRemoteJob())
+	assert.ErrorIs(t, err, ErrNoHealthyWorker)
+}
+
+func TestRemoteHttpExecutor_RegisterWorker(t *testing.T) {
+	e := NewRemoteHttpExecutor(context.Background(), &RemoteHttpExecutorConfig{})
+	assert.Error(t, e.RegisterWorker("not a url"))
+	assert.NoError(t, e.RegisterWorker("http://203.0.113.7:8080"))
+	assert.NoError(t, e.RegisterWorker("http://203.0.113.7:8080"))
+	assert.Len(t, e.workers, 1)
+	e.DeregisterWorker("http://203.0.113.7:8080")
+	assert.Len(t, e.workers, 0)
+}
@@ -2,23 +2,28 @@ package modules
 
 import (
 	"context"
+	"time"
 
 	"github.com/DataDog/datadog-go/statsd"
 	"github.com/Luismorlan/newsmux/panoptic"
 	"github.com/Luismorlan/newsmux/protocol"
 	"github.com/Luismorlan/newsmux/utils"
 	Logger "github.com/Luismorlan/newsmux/utils/log"
-	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
 	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 )
 
 type ReporterConfig struct {
 	Name string
+
+	// Task history older than this is pruned, defaults to
+	// DefaultTaskRunRetention.
+	TaskRunRetention time.Duration
 }
 
 // Reporter's job is to listen to different channels and aggregate results,
 // sending to Datadog (Or other service if there's any) for monitoring purpose.
-//
+// Executed tasks are also persisted into DB as task history.
 type Reporter struct {
 	panoptic.Module
 
@@ -26,13 +31,19 @@ type Reporter struct {
 
 	Statsd *statsd.Client
 
-	EventBus *gochannel.GoChannel
+	// DB to persist task history, skipped if nil.
+	DB *gorm.DB
+
+	EventBus panoptic.EventBus
+
+	lastPrunedAt time.Time
 }
 
-func NewReporter(config ReporterConfig, statsd *statsd.Client, e *gochannel.GoChannel) *Reporter {
+func NewReporter(config ReporterConfig, statsd *statsd.Client, db *gorm.DB, e panoptic.EventBus) *Reporter {
 	return &Reporter{
 		Config:   config,
 		Statsd:   statsd,
+		DB:       db,
 		EventBus: e,
 	}
 }
@@ -104,37 +115,64 @@ func (r *Reporter) ProcessPanopticJobs(ctx context.Context) error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	messages, err := r.EventBus.Subscribe(ctx, panoptic.TopicExecutedJob)
+	messages, err := r.EventBus.Subscribe(ctx, panoptic.TopicExecutedJob, r.Config.Name)
 	if err != nil {
 		return err
 	}
 
 	for msg := range messages {
-		msg.Ack()
-
 		job := protocol.PanopticJob{}
 		err := proto.Unmarshal(msg.Payload, &job)
 
 		if err != nil {
-			return err
+			Logger.Log.Errorf("fail to unmarshal executed job, dropped: %s", err)
+			msg.Ack()
+			continue
 		}
 
 		Logger.Log.Infof("reporter received PanopticJob: %s", job.String())
 
 		// Export metrics to Datadog only if we're in prod environment, so that
 		// local testing won't pollute the Datadog dashboard.
-		if !utils.IsProdEnv() {
-			continue
+		if utils.IsProdEnv() {
+			r.ReportTask(&job)
 		}
-		r.ReportTask(&job)
+		if r.DB != nil {
+			// Task history is best effort, a failure shouldn't block reporting.
+			if err := SaveTaskRuns(r.DB, &job); err != nil {
+				Logger.Log.Errorf("fail to save task history of job %s: %s", job.JobId, err)
+			}
+			r.maybePruneTaskRuns(time.Now())
+		}
+		msg.Ack()
 	}
 
 	return nil
 }
 
+// Prune expired task history at most once per taskRunPruneInterval.
+func (r *Reporter) maybePruneTaskRuns(now time.Time) {
+	if now.Sub(r.lastPrunedAt) < taskRunPruneInterval {
+		return
+	}
+	r.lastPrunedAt = now
+
+	retention := r.Config.TaskRunRetention
+	if retention <= 0 {
+		retention = DefaultTaskRunRetention
+	}
+	pruned, err := PruneTaskRuns(r.DB, now.Add(-retention))
+	if err != nil {
+		Logger.Log.Errorf("fail to prune task history: %s", err)
+		return
+	}
+	if pruned > 0 {
+		Logger.Log.Infof("pruned %d task runs older than %s", pruned, retention)
+	}
+}
+
 func (r *Reporter) RunModule(ctx context.Context) error {
-	r.ProcessPanopticJobs(ctx)
-	return nil
+	return r.ProcessPanopticJobs(ctx)
 }
 
 func (r *Reporter) Name() string {
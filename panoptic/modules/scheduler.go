@@ -2,18 +2,13 @@ package modules
 
 import (
 	"context"
-	"encoding/base64"
+	"errors"
 	"fmt"
-	"io/ioutil"
 	"log"
-	"os"
 	"sync"
 	"time"
 
-	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
-	"github.com/google/go-github/github"
-	"golang.org/x/oauth2"
-	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 
 	"github.com/Luismorlan/newsmux/app_setting"
@@ -25,7 +20,10 @@ import (
 
 var AppSetting *app_setting.PanopticAppSetting
 
-// A valid job batch must not contains duplicate job name.
+var ErrJobNotFound = errors.New("scheduler job not found")
+
+// A valid job batch must not contains duplicate job name, and every job must
+// have a valid schedule.
 func ValidateJobs(jobs []*SchedulerJob) error {
 	seen := make(map[string]bool)
 	for _, job := range jobs {
@@ -33,6 +31,9 @@ func ValidateJobs(jobs []*SchedulerJob) error {
 			return fmt.Errorf("duplicate scheduler job name: %s", job.panopticConfig.Name)
 		}
 		seen[job.panopticConfig.Name] = true
+		if _, err := job.CalculateInterval(); err != nil {
+			return fmt.Errorf("invalid schedule of job %s: %w", job.panopticConfig.Name, err)
+		}
 	}
 	return nil
 }
@@ -42,6 +43,19 @@ type SchedulerConfig struct {
 	Name string
 }
 
+// ConfigStatus reports how scheduler config loading is going. On a failed
+// load, scheduler keeps running jobs of the last good config.
+type ConfigStatus struct {
+	Source            string     `json:"source"`
+	Digest            string     `json:"digest"`
+	JobCount          int        `json:"jobCount"`
+	LastCheckedAt     *time.Time `json:"lastCheckedAt,omitempty"`
+	LastLoadedAt      *time.Time `json:"lastLoadedAt,omitempty"`
+	LastError         string     `json:"lastError,omitempty"`
+	LastErrorAt       *time.Time `json:"lastErrorAt,omitempty"`
+	ConsecutiveErrors int        `json:"consecutiveErrors"`
+}
+
 type Scheduler struct {
 	m sync.RWMutex
 
@@ -64,15 +78,29 @@ type Scheduler struct {
 	// mostly emitting PanopticJob.
 	Doer JobDoer
 
-	EventBus *gochannel.GoChannel
+	EventBus panoptic.EventBus
 
 	DB *gorm.DB
+
+	// Where PanopticConfigs are read from.
+	ConfigSource ConfigSource
+
+	// Result of config loading, guarded by m.
+	configStatus ConfigStatus
+
+	// If set, jobs are only scheduled while this instance is the leader.
+	// Config is still loaded by followers so that they take over promptly.
+	Elector *LeaderElector
+
+	// Parent context of scheduled jobs, which is cancelled when this instance
+	// steps down as leader. Guarded by m.
+	leaderCtx context.Context
 }
 
 // Return a new instance of Scheduler.
 func NewScheduler(
 	panopticAppSetting *app_setting.PanopticAppSetting, config SchedulerConfig,
-	e *gochannel.GoChannel, doer JobDoer, ctx context.Context) *Scheduler {
+	e panoptic.EventBus, doer JobDoer, ctx context.Context) *Scheduler {
 	AppSetting = panopticAppSetting
 
 	db, err := utils.GetDBConnection()
@@ -80,6 +108,11 @@ func NewScheduler(
 		Logger.Log.Errorln("failed to connect to database")
 	}
 
+	source, err := NewConfigSourceFromAppSetting(ctx, panopticAppSetting, db)
+	if err != nil {
+		Logger.Log.Fatalf("invalid config sources: %v", err)
+	}
+
 	scheduler := &Scheduler{
 		Config:         config,
 		ctx:            ctx,
@@ -88,6 +121,7 @@ func NewScheduler(
 		Doer:           doer,
 		running:        false,
 		DB:             db,
+		ConfigSource:   source,
 	}
 	return scheduler
 }
@@ -121,6 +155,11 @@ func (s *Scheduler) UpsertJobs(jobs []*SchedulerJob) {
 			// Existing job found. Update it's PanopticConfig. Also delete it from
 			// nameToJobMap.
 			delete(nameToJobMap, existingJob.panopticConfig.Name)
+			// A changed config may have fixed whatever failed the job, a job
+			// paused by admin stays paused.
+			if !proto.Equal(existingJob.panopticConfig, v.panopticConfig) {
+				s.PublishAlert(existingJob.ResumeAutoPaused("config changed"))
+			}
 			existingJob.panopticConfig = v.panopticConfig
 			idx += 1
 		} else {
@@ -136,15 +175,21 @@ func (s *Scheduler) UpsertJobs(jobs []*SchedulerJob) {
 	}
 }
 
-// Read config either from local workspace (dev) or from Github (production)
-// In addition to the config, we read from DB and add more subsources to each source in the configs
+// Read config from the config source. In addition to the config, we read from
+// DB and add more subsources to each source in the configs, and split configs
+// with adaptive polling into tiers by posting rate.
 func (s *Scheduler) ReadConfig() (*protocol.PanopticConfigs, string, error) {
-	configs, err := s.ReadConfigFromLocalOrGithub()
+	configs, err := s.ConfigSource.Read(s.ctx)
 	if err != nil {
 		return nil, "", err
 	}
 
-	panoptic.MergeSubsourcesFromConfigAndDb(s.DB, configs)
+	if s.DB != nil {
+		panoptic.MergeSubsourcesFromConfigAndDb(s.DB, configs)
+		// Tiers are part of the digest, jobs are rescheduled when subsources
+		// move between tiers.
+		ApplyAdaptivePolling(s.DB, configs)
+	}
 
 	digest, err := utils.TextToMd5Hash(configs.String())
 	if err != nil {
@@ -154,41 +199,6 @@ func (s *Scheduler) ReadConfig() (*protocol.PanopticConfigs, string, error) {
 	return configs, digest, nil
 }
 
-func (s *Scheduler) ReadConfigFromLocalOrGithub() (*protocol.PanopticConfigs, error) {
-	configs := &protocol.PanopticConfigs{}
-
-	if AppSetting.FORCE_REMOTE_SCHEDULE_PULL || utils.IsProdEnv() {
-		Logger.Log.Infoln("read PanopticConfig from Github project: https://github.com/Luismorlan/panoptic_config")
-		ts := oauth2.StaticTokenSource(
-			&oauth2.Token{AccessToken: os.Getenv("GITHUB_ACCESS_TOKEN")},
-		)
-		tc := oauth2.NewClient(s.ctx, ts)
-		client := github.NewClient(tc)
-		content, _, res, err := client.Repositories.GetContents(s.ctx, "Luismorlan", "panoptic_config", "config.textproto", nil)
-		if err != nil {
-			return nil, err
-		}
-		if res.StatusCode != 200 {
-			return nil, fmt.Errorf("fail to get config from Github, http code %d", res.StatusCode)
-		}
-		decode, _ := base64.StdEncoding.DecodeString(*content.Content)
-		if err := prototext.Unmarshal(decode, configs); err != nil {
-			return nil, err
-		}
-	} else {
-		Logger.Log.Infoln("read PanopticConfig from local workspace, file", AppSetting.LOCAL_PANOPTIC_CONFIG_PATH)
-		in, err := ioutil.ReadFile(AppSetting.LOCAL_PANOPTIC_CONFIG_PATH)
-		if err != nil {
-			return nil, err
-		}
-		if err := prototext.Unmarshal(in, configs); err != nil {
-			return nil, err
-		}
-	}
-
-	return configs, nil
-}
-
 func (s *Scheduler) ParseAndUpsertJobs() ( /*reschedule*/ bool, error) {
 	configs, digest, err := s.ReadConfig()
 	if err != nil {
@@ -214,7 +224,215 @@ func (s *Scheduler) ParseAndUpsertJobs() ( /*reschedule*/ bool, error) {
 	return true, nil
 }
 
+func (s *Scheduler) recordConfigLoad(err error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	now := time.Now()
+	status := &s.configStatus
+	status.LastCheckedAt = &now
+	if err != nil {
+		status.LastError = err.Error()
+		status.LastErrorAt = &now
+		status.ConsecutiveErrors++
+		return
+	}
+	status.LastLoadedAt = &now
+	status.LastError = ""
+	status.ConsecutiveErrors = 0
+}
+
+// ConfigStatus returns a snapshot of config loading status.
+func (s *Scheduler) ConfigStatus() ConfigStatus {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	status := s.configStatus
+	if s.ConfigSource != nil {
+		status.Source = s.ConfigSource.Name()
+	}
+	status.Digest = s.ScheduleDigest
+	status.JobCount = len(s.Jobs)
+	return status
+}
+
+// PublishAlert publishes alert onto EventBus, nil alert is ignored.
+func (s *Scheduler) PublishAlert(alert *protocol.PanopticAlert) {
+	PublishAlert(s.EventBus, alert)
+}
+
+// RecordJobResult feeds results of an executed PanopticJob back to the
+// SchedulerJobs that emitted its tasks. A deferred task doesn't count as a
+// result, its job is executed again once its domain has budget.
+func (s *Scheduler) RecordJobResult(job *protocol.PanopticJob) {
+	s.m.RLock()
+	jobByName := make(map[string]*SchedulerJob)
+	for _, j := range s.Jobs {
+		jobByName[j.panopticConfig.Name] = j
+	}
+	s.m.RUnlock()
+
+	for _, task := range job.Tasks {
+		// Dry runs are debug executions triggered by admin under the real config
+		// name, they shouldn't back off or pause the config.
+		if task.GetTaskMetadata().GetDryRun() {
+			continue
+		}
+		j, ok := jobByName[task.GetTaskMetadata().GetConfigName()]
+		if !ok {
+			continue
+		}
+		if task.GetTaskMetadata().GetResultState() == protocol.TaskMetadata_STATE_DEFERRED {
+			j.Defer(time.Duration(task.GetTaskMetadata().GetRetryAfterMilliseconds()) * time.Millisecond)
+			continue
+		}
+		s.PublishAlert(j.RecordResult(task.GetTaskMetadata().GetResultState()))
+	}
+}
+
+// ProcessExecutedJobs listens to executed jobs until ctx is done, so that
+// failing jobs back off and eventually pause. With leader election only the
+// leader listens, since followers don't run jobs. All leaders share the same
+// consumer group, a new leader continues from where the last one stopped.
+func (s *Scheduler) ProcessExecutedJobs(ctx context.Context) error {
+	messages, err := s.EventBus.Subscribe(ctx, panoptic.TopicExecutedJob, s.Config.Name)
+	if err != nil {
+		return err
+	}
+
+	for msg := range messages {
+		job := protocol.PanopticJob{}
+		if err := proto.Unmarshal(msg.Payload, &job); err != nil {
+			Logger.Log.Errorf("fail to unmarshal executed job: %v", err)
+		} else {
+			s.RecordJobResult(&job)
+		}
+		msg.Ack()
+	}
+	return nil
+}
+
+// syncAdminPauses applies admin pauses persisted in DB to the given jobs, so
+// that a pause requested on any instance is respected by the leader. Pauses in
+// memory are kept if DB isn't available.
+func (s *Scheduler) syncAdminPauses(jobs ...*SchedulerJob) {
+	if s.DB == nil || len(jobs) == 0 {
+		return
+	}
+	names := []string{}
+	for _, job := range jobs {
+		names = append(names, job.panopticConfig.Name)
+	}
+	paused, err := PausedConfigNames(s.DB, names...)
+	if err != nil {
+		Logger.Log.Errorf("fail to load paused configs: %v", err)
+		return
+	}
+	for _, job := range jobs {
+		job.SyncAdminPause(paused[job.panopticConfig.Name])
+	}
+}
+
+// JobStatuses returns a snapshot of all jobs.
+func (s *Scheduler) JobStatuses() []JobStatus {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	s.syncAdminPauses(s.Jobs...)
+
+	res := []JobStatus{}
+	for _, job := range s.Jobs {
+		res = append(res, job.Status())
+	}
+	return res
+}
+
+// FindJob returns the job of the given config name, or ErrJobNotFound.
+func (s *Scheduler) FindJob(name string) (*SchedulerJob, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+
+	for _, job := range s.Jobs {
+		if job.panopticConfig.Name == name {
+			return job, nil
+		}
+	}
+	return nil, ErrJobNotFound
+}
+
+// TriggerJob executes a job immediately regardless of its schedule, even if
+// it's paused. A dry run executes a debug copy of the job, which doesn't count
+// as a run of the job.
+func (s *Scheduler) TriggerJob(name string, dryRun bool) error {
+	job, err := s.FindJob(name)
+	if err != nil {
+		return err
+	}
+	if dryRun {
+		// Config of a job is replaced under scheduler lock on config change.
+		s.m.RLock()
+		config := proto.Clone(job.panopticConfig).(*protocol.PanopticConfig)
+		s.m.RUnlock()
+		config.DryRun = true
+		job = NewSchedulerJob(config, s.ctx)
+	}
+	return s.Doer.Do(job)
+}
+
+// PauseJob pauses a job until admin resumes it. The pause is persisted so that
+// it survives restarts and applies to the leader even if requested on a
+// follower.
+func (s *Scheduler) PauseJob(name string, reason string) error {
+	job, err := s.FindJob(name)
+	if err != nil {
+		return err
+	}
+	if s.DB != nil {
+		if err := SaveJobPause(s.DB, name, reason); err != nil {
+			return fmt.Errorf("fail to persist pause of job %s: %w", name, err)
+		}
+	}
+	s.PublishAlert(job.Pause(reason))
+	return nil
+}
+
+// ResumeJob resumes a paused job and resets its failures.
+func (s *Scheduler) ResumeJob(name string, reason string) error {
+	job, err := s.FindJob(name)
+	if err != nil {
+		return err
+	}
+	if s.DB != nil {
+		if err := DeleteJobPause(s.DB, name); err != nil {
+			return fmt.Errorf("fail to persist resume of job %s: %w", name, err)
+		}
+	}
+	s.PublishAlert(job.Resume(reason))
+	return nil
+}
+
+// IsLeading returns whether this scheduler should schedule jobs.
+func (s *Scheduler) IsLeading() bool {
+	if s.Elector == nil {
+		return true
+	}
+	s.m.RLock()
+	leading := s.leaderCtx != nil && s.leaderCtx.Err() == nil
+	s.m.RUnlock()
+	return leading && s.Elector.IsLeader()
+}
+
 func (s *Scheduler) DoSingleJob(job *SchedulerJob) {
+	// Leadership may be lost between two runs of a job.
+	if !s.IsLeading() {
+		log.Printf("Job %s skipped, not the leader.", job.panopticConfig.Name)
+		return
+	}
+	s.syncAdminPauses(job)
+	if job.IsPaused() {
+		log.Printf("Job %s is paused, skipped.", job.panopticConfig.Name)
+		return
+	}
 	err := s.Doer.Do(job)
 	if err != nil {
 		log.Printf(
@@ -248,6 +466,8 @@ func (s *Scheduler) ScheduleSingleJob(job *SchedulerJob) {
 		case <-job.ctx.Done():
 			log.Printf("Job %s cancelled by itself.", job.panopticConfig.Name)
 			return
+		// Next run is brought forward, wait for the new time instead.
+		case <-job.rescheduled:
 		case <-time.After(durationTillNextRun):
 			job.UpdateLastAndNextTime()
 			go s.DoSingleJob(job)
@@ -265,8 +485,12 @@ func (s *Scheduler) ScheduleJobs() {
 
 	// Critical section.
 	s.m.RLock()
+	parent := s.ctx
+	if s.leaderCtx != nil {
+		parent = s.leaderCtx
+	}
 	for _, j := range s.Jobs {
-		j.RefreshContext(s.ctx)
+		j.RefreshContext(parent)
 
 		wg.Add(1)
 		go func(job *SchedulerJob) {
@@ -280,21 +504,77 @@ func (s *Scheduler) ScheduleJobs() {
 	log.Println("SchedulerJobs ended with config digest: ", digest)
 }
 
+// Reload config every poll interval, or earlier when the config source
+// notifies a change. A bad config is logged and recorded in ConfigStatus, jobs
+// of the last good config keep running.
 func (s *Scheduler) WatchConfigAndMaybeReschedule() {
+	changed := s.ConfigSource.Changed()
 	for {
 		reschedule, err := s.ParseAndUpsertJobs()
+		s.recordConfigLoad(err)
 		if err != nil {
-			Logger.Log.Errorf("error parsing config: %s", err)
+			Logger.Log.Errorf("error parsing config, keep running last good config %s: %s", s.ScheduleDigest, err)
 		}
-		if reschedule {
+		if reschedule && s.IsLeading() {
 			go s.ScheduleJobs()
 		}
 
-		time.Sleep(time.Duration(AppSetting.SCHEDULER_CONFIG_POLL_INTERVAL_SECOND) * time.Second)
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-changed:
+			Logger.Log.Infoln("config source changed, reloading config from", s.ConfigSource.Name())
+		case <-time.After(time.Duration(AppSetting.SCHEDULER_CONFIG_POLL_INTERVAL_SECOND) * time.Second):
+		}
+	}
+}
+
+// ScheduleWhileLeading schedules jobs each time this instance becomes leader,
+// jobs are cancelled when it steps down.
+func (s *Scheduler) ScheduleWhileLeading(ctx context.Context) {
+	for {
+		leaderCtx, err := s.Elector.WaitForLeadership(ctx)
+		if err != nil {
+			return
+		}
+		jobsCtx, cancel := context.WithCancel(s.ctx)
+		go func() {
+			select {
+			case <-leaderCtx.Done():
+			case <-jobsCtx.Done():
+			}
+			cancel()
+		}()
+
+		s.m.Lock()
+		s.leaderCtx = jobsCtx
+		s.m.Unlock()
+
+		log.Println("Scheduler becomes leader, scheduling jobs.")
+		go s.ScheduleJobs()
+		go func() {
+			if err := s.ProcessExecutedJobs(jobsCtx); err != nil {
+				Logger.Log.Errorf("fail to process executed jobs: %v", err)
+			}
+		}()
+		<-jobsCtx.Done()
+		log.Println("Scheduler is no longer leader, jobs cancelled.")
 	}
 }
 
 func (s *Scheduler) RunModule(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if s.Elector != nil {
+		go s.ScheduleWhileLeading(ctx)
+	} else {
+		go func() {
+			if err := s.ProcessExecutedJobs(ctx); err != nil {
+				Logger.Log.Errorf("fail to process executed jobs: %v", err)
+			}
+		}()
+	}
 	s.WatchConfigAndMaybeReschedule()
 	return nil
 }
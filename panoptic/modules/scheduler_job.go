@@ -3,14 +3,32 @@ package modules
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"sync"
 	"time"
 
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Luismorlan/newsmux/protocol"
 )
 
+const defaultMaxBackoff = time.Hour
+
+// PauseSource tells who paused a job, which decides what resumes it.
+type PauseSource string
+
+const (
+	// Paused by failure policy after consecutive failures, resumed by a
+	// successful execution, a config change or admin.
+	PausedByFailures PauseSource = "failures"
+	// Paused by admin, only resumed by admin.
+	PausedByAdmin PauseSource = "admin"
+)
+
+// Source of randomness for jitter, replaced in tests.
+var randInt63n = rand.Int63n
+
 // SchedulerJob defines the jobs which scheduler manages. Scheduler periodically
 // transform those SchedulerJob into PanopticJobs, and send to event bus.
 // It's worth noting that SchedulerJob and PanopticJob are not the same things,
@@ -37,6 +55,16 @@ type SchedulerJob struct {
 
 	// How many times this job is scheduled on EventBus.
 	runCount int64
+
+	// How many executions of this job failed in a row.
+	consecutiveFailures int32
+
+	// A paused job is not executed until it's resumed, empty if not paused.
+	pausedBy PauseSource
+
+	// Notified when nextRun is brought forward, so that the schedule loop
+	// waits for the new time.
+	rescheduled chan struct{}
 }
 
 func NewSchedulerJobs(configs *protocol.PanopticConfigs, ctx context.Context) []*SchedulerJob {
@@ -57,6 +85,7 @@ func NewSchedulerJob(config *protocol.PanopticConfig, ctx context.Context) *Sche
 		ctx:            ctx,
 		cancel:         cancel,
 		runCount:       0,
+		rescheduled:    make(chan struct{}, 1),
 	}
 }
 
@@ -125,7 +154,9 @@ func (j *SchedulerJob) IncrementRunCount() {
 func (j *SchedulerJob) DurationTillNextRun() time.Duration {
 	duration, _ := j.CalculateInterval()
 	if !j.HasRunBefore() {
-		return duration
+		j.m.RLock()
+		defer j.m.RUnlock()
+		return j.delay(duration)
 	}
 
 	j.m.RLock()
@@ -136,29 +167,212 @@ func (j *SchedulerJob) DurationTillNextRun() time.Duration {
 }
 
 func (j *SchedulerJob) UpdateLastAndNextTime() error {
-	duration, err := j.CalculateInterval()
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	now := time.Now()
+	next, err := NextRunTime(j.panopticConfig.TaskSchedule, now)
 	if err != nil {
 		return err
 	}
 
+	j.lastRun = now
+	j.nextRun = now.Add(j.delay(next.Sub(now)))
+	return nil
+}
+
+// delay applies failure backoff and jitter to the scheduled interval. Caller
+// must hold the lock.
+func (j *SchedulerJob) delay(interval time.Duration) time.Duration {
+	policy := j.panopticConfig.GetFailurePolicy()
+	if j.consecutiveFailures > 0 && !policy.GetDisableBackoff() {
+		maxBackoff := defaultMaxBackoff
+		if policy.GetMaxBackoffMilliseconds() > 0 {
+			maxBackoff = time.Duration(policy.GetMaxBackoffMilliseconds()) * time.Millisecond
+		}
+		backoff := interval
+		for i := int32(0); i < j.consecutiveFailures && backoff < maxBackoff; i++ {
+			backoff *= 2
+		}
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+		// Randomize the second half of backoff, so that jobs failing together
+		// don't retry together. Backoff is never shorter than the schedule.
+		if backoff > interval {
+			backoff = backoff/2 + time.Duration(randInt63n(int64(backoff/2)+1))
+		}
+		if backoff > interval {
+			interval = backoff
+		}
+	}
+
+	if jitter := j.panopticConfig.TaskSchedule.GetJitterMilliseconds(); jitter > 0 {
+		interval += time.Duration(randInt63n(jitter)) * time.Millisecond
+	}
+	return interval
+}
+
+// RecordResult updates failure count with an execution result of this job.
+// Returns an alert if the job is paused or resumed by the result.
+func (j *SchedulerJob) RecordResult(state protocol.TaskMetadata_TaskResultState) *protocol.PanopticAlert {
 	j.m.Lock()
 	defer j.m.Unlock()
 
-	j.lastRun = time.Now()
-	j.nextRun = j.lastRun.Add(duration)
+	switch state {
+	case protocol.TaskMetadata_STATE_SUCCESS:
+		j.consecutiveFailures = 0
+		// An execution in flight when the job is paused by failures succeeded.
+		if j.pausedBy == PausedByFailures {
+			j.pausedBy = ""
+			return j.newAlert(protocol.PanopticAlert_ALERT_CONFIG_RESUMED, "resumed after a successful execution")
+		}
+	case protocol.TaskMetadata_STATE_FAILURE:
+		j.consecutiveFailures += 1
+		threshold := j.panopticConfig.GetFailurePolicy().GetPauseAfterConsecutiveFailures()
+		if j.pausedBy == "" && threshold > 0 && j.consecutiveFailures >= threshold {
+			j.pausedBy = PausedByFailures
+			return j.newAlert(protocol.PanopticAlert_ALERT_CONFIG_PAUSED,
+				fmt.Sprintf("paused after %d consecutive failures", j.consecutiveFailures))
+		}
+	}
 	return nil
 }
 
+// Defer executes the job again after the given time if it's sooner than the
+// next run, used when the last execution is deferred for lack of budget.
+func (j *SchedulerJob) Defer(after time.Duration) {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	next := time.Now().Add(after)
+	if !next.Before(j.nextRun) {
+		return
+	}
+	j.nextRun = next
+	select {
+	case j.rescheduled <- struct{}{}:
+	default:
+	}
+}
+
+// Resume resets failures of the job and resumes it however it's paused, used
+// by admin. Returns an alert if the job was paused.
+func (j *SchedulerJob) Resume(reason string) *protocol.PanopticAlert {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	wasPaused := j.pausedBy != ""
+	j.pausedBy = ""
+	j.consecutiveFailures = 0
+	if !wasPaused {
+		return nil
+	}
+	return j.newAlert(protocol.PanopticAlert_ALERT_CONFIG_RESUMED, "resumed, "+reason)
+}
+
+// ResumeAutoPaused resets failures of the job and resumes it only if it's
+// paused by failures, a job paused by admin stays paused. Returns an alert if
+// the job is resumed.
+func (j *SchedulerJob) ResumeAutoPaused(reason string) *protocol.PanopticAlert {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	j.consecutiveFailures = 0
+	if j.pausedBy != PausedByFailures {
+		return nil
+	}
+	j.pausedBy = ""
+	return j.newAlert(protocol.PanopticAlert_ALERT_CONFIG_RESUMED, "resumed, "+reason)
+}
+
+// Pause stops the job from executing until admin resumes it, a job paused by
+// failures is taken over by admin. Returns an alert if the job wasn't paused.
+func (j *SchedulerJob) Pause(reason string) *protocol.PanopticAlert {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	wasPaused := j.pausedBy != ""
+	j.pausedBy = PausedByAdmin
+	if wasPaused {
+		return nil
+	}
+	return j.newAlert(protocol.PanopticAlert_ALERT_CONFIG_PAUSED, "paused, "+reason)
+}
+
+// SyncAdminPause applies an admin pause persisted by any instance without
+// alerting, which was already done by the instance handling the request. A
+// pause by failures is kept if admin pause doesn't exist.
+func (j *SchedulerJob) SyncAdminPause(paused bool) {
+	j.m.Lock()
+	defer j.m.Unlock()
+
+	if paused {
+		j.pausedBy = PausedByAdmin
+	} else if j.pausedBy == PausedByAdmin {
+		j.pausedBy = ""
+		j.consecutiveFailures = 0
+	}
+}
+
+// Caller must hold the lock.
+func (j *SchedulerJob) newAlert(alertType protocol.PanopticAlert_AlertType, message string) *protocol.PanopticAlert {
+	return &protocol.PanopticAlert{
+		Type:                alertType,
+		ConfigName:          j.panopticConfig.Name,
+		Message:             fmt.Sprintf("config %s %s", j.panopticConfig.Name, message),
+		Time:                timestamppb.Now(),
+		ConsecutiveFailures: j.consecutiveFailures,
+	}
+}
+
+func (j *SchedulerJob) IsPaused() bool {
+	j.m.RLock()
+	defer j.m.RUnlock()
+
+	return j.pausedBy != ""
+}
+
+// JobStatus is a snapshot of a SchedulerJob for status report.
+type JobStatus struct {
+	Name                string     `json:"name"`
+	Paused              bool       `json:"paused"`
+	PausedBy            string     `json:"pausedBy,omitempty"`
+	ConsecutiveFailures int32      `json:"consecutiveFailures"`
+	RunCount            int64      `json:"runCount"`
+	LastRun             *time.Time `json:"lastRun,omitempty"`
+	NextRun             *time.Time `json:"nextRun,omitempty"`
+}
+
+func (j *SchedulerJob) Status() JobStatus {
+	j.m.RLock()
+	defer j.m.RUnlock()
+
+	status := JobStatus{
+		Name:                j.panopticConfig.Name,
+		Paused:              j.pausedBy != "",
+		PausedBy:            string(j.pausedBy),
+		ConsecutiveFailures: j.consecutiveFailures,
+		RunCount:            j.runCount,
+	}
+	if !j.lastRun.IsZero() {
+		lastRun, nextRun := j.lastRun, j.nextRun
+		status.LastRun = &lastRun
+		status.NextRun = &nextRun
+	}
+	return status
+}
+
+// CalculateInterval returns the duration from now till the next run time of
+// the schedule.
 func (j *SchedulerJob) CalculateInterval() (time.Duration, error) {
 	j.m.RLock()
 	defer j.m.RUnlock()
 
-	switch scheduleType := j.panopticConfig.TaskSchedule.Schedule.(type) {
-	case *protocol.TaskSchedule_Routinely:
-		return time.Duration(
-			j.panopticConfig.TaskSchedule.GetRoutinely().EveryMilliseconds) *
-			time.Millisecond, nil
-	default:
-		return 0, fmt.Errorf("unknown schedule type: %T", scheduleType)
+	now := time.Now()
+	next, err := NextRunTime(j.panopticConfig.TaskSchedule, now)
+	if err != nil {
+		return 0, err
 	}
+	return next.Sub(now), nil
 }
@@ -2,12 +2,14 @@ package modules
 
 import (
 	"context"
+	"math/rand"
 	"testing"
 	"time"
 
 	"github.com/Luismorlan/newsmux/protocol"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/testing/protocmp"
 )
@@ -159,3 +161,134 @@ func TestMaybeSplitIntoMultipleSchedulerJobs_UnsetShouldReturnOnlyOneJob(t *test
 	assert.Equal(t, jobs[0].panopticConfig.TaskParams.SubSources[4].Name, "ss_5")
 	assert.Equal(t, jobs[0].panopticConfig.Name, "test")
 }
+
+const FailurePolicyPanopticConfig = `
+	name: "failing"
+	data_collector_id: COLLECTOR_JINSHI
+	task_schedule: {
+		routinely: {
+			every_milliseconds: 1000
+		}
+	}
+	failure_policy: {
+		max_backoff_milliseconds: 10000
+		pause_after_consecutive_failures: 3
+	}
+`
+
+// Make jitter deterministic, returning the upper bound.
+func useMaxJitter(t *testing.T) {
+	randInt63n = func(n int64) int64 { return n - 1 }
+	t.Cleanup(func() { randInt63n = rand.Int63n })
+}
+
+func TestDelay_BackoffOnFailures(t *testing.T) {
+	useMaxJitter(t)
+	job := GetCustomizedSchedulerJob(t, FailurePolicyPanopticConfig)
+	assert.Equal(t, time.Second, job.delay(time.Second))
+
+	job.RecordResult(protocol.TaskMetadata_STATE_FAILURE)
+	assert.Equal(t, 2*time.Second, job.delay(time.Second))
+
+	job.RecordResult(protocol.TaskMetadata_STATE_FAILURE)
+	assert.Equal(t, 4*time.Second, job.delay(time.Second))
+
+	// Capped by max backoff.
+	job.consecutiveFailures = 10
+	assert.Equal(t, 10*time.Second, job.delay(time.Second))
+	// Never shorter than the schedule.
+	assert.Equal(t, 20*time.Second, job.delay(20*time.Second))
+
+	// Success resets backoff.
+	job.RecordResult(protocol.TaskMetadata_STATE_SUCCESS)
+	assert.Equal(t, time.Second, job.delay(time.Second))
+}
+
+func TestDelay_BackoffDisabled(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, FailurePolicyPanopticConfig)
+	job.panopticConfig.FailurePolicy.DisableBackoff = true
+	job.RecordResult(protocol.TaskMetadata_STATE_FAILURE)
+	assert.Equal(t, time.Second, job.delay(time.Second))
+}
+
+func TestDelay_Jitter(t *testing.T) {
+	useMaxJitter(t)
+	job := GetDefaultSchedulerJob(t)
+	job.panopticConfig.TaskSchedule.JitterMilliseconds = 500
+	assert.Equal(t, 1499*time.Millisecond, job.delay(time.Second))
+}
+
+func TestRecordResult_PauseAndResume(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, FailurePolicyPanopticConfig)
+
+	assert.Nil(t, job.RecordResult(protocol.TaskMetadata_STATE_FAILURE))
+	assert.Nil(t, job.RecordResult(protocol.TaskMetadata_STATE_FAILURE))
+	assert.False(t, job.IsPaused())
+
+	alert := job.RecordResult(protocol.TaskMetadata_STATE_FAILURE)
+	require.NotNil(t, alert)
+	assert.Equal(t, protocol.PanopticAlert_ALERT_CONFIG_PAUSED, alert.Type)
+	assert.Equal(t, "failing", alert.ConfigName)
+	assert.Equal(t, int32(3), alert.ConsecutiveFailures)
+	assert.True(t, job.IsPaused())
+
+	// Alert only once.
+	assert.Nil(t, job.RecordResult(protocol.TaskMetadata_STATE_FAILURE))
+
+	alert = job.Resume("manually")
+	require.NotNil(t, alert)
+	assert.Equal(t, protocol.PanopticAlert_ALERT_CONFIG_RESUMED, alert.Type)
+	assert.False(t, job.IsPaused())
+	assert.Equal(t, int32(0), job.Status().ConsecutiveFailures)
+
+	assert.Nil(t, job.Resume("manually"))
+}
+
+func TestRecordResult_KeepsAdminPause(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, FailurePolicyPanopticConfig)
+
+	require.NotNil(t, job.Pause("by admin"))
+	// A success of an execution in flight doesn't resume an admin pause.
+	assert.Nil(t, job.RecordResult(protocol.TaskMetadata_STATE_SUCCESS))
+	assert.True(t, job.IsPaused())
+	assert.Nil(t, job.ResumeAutoPaused("config changed"))
+	assert.True(t, job.IsPaused())
+
+	require.NotNil(t, job.Resume("by admin"))
+	assert.False(t, job.IsPaused())
+
+	// Admin takes over a pause by failures, which then stays paused.
+	for i := 0; i < 3; i++ {
+		job.RecordResult(protocol.TaskMetadata_STATE_FAILURE)
+	}
+	assert.Equal(t, string(PausedByFailures), job.Status().PausedBy)
+	assert.Nil(t, job.Pause("by admin"))
+	assert.Nil(t, job.RecordResult(protocol.TaskMetadata_STATE_SUCCESS))
+	assert.Equal(t, string(PausedByAdmin), job.Status().PausedBy)
+}
+
+func TestSyncAdminPause(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, FailurePolicyPanopticConfig)
+
+	job.SyncAdminPause(true)
+	assert.Equal(t, string(PausedByAdmin), job.Status().PausedBy)
+	job.SyncAdminPause(false)
+	assert.False(t, job.IsPaused())
+
+	// Pause by failures isn't resumed without an admin pause.
+	for i := 0; i < 3; i++ {
+		job.RecordResult(protocol.TaskMetadata_STATE_FAILURE)
+	}
+	job.SyncAdminPause(false)
+	assert.Equal(t, string(PausedByFailures), job.Status().PausedBy)
+	job.SyncAdminPause(true)
+	assert.Equal(t, string(PausedByAdmin), job.Status().PausedBy)
+}
+
+func TestRecordResult_NeverPauseByDefault(t *testing.T) {
+	job := GetDefaultSchedulerJob(t)
+	for i := 0; i < 100; i++ {
+		assert.Nil(t, job.RecordResult(protocol.TaskMetadata_STATE_FAILURE))
+	}
+	assert.False(t, job.IsPaused())
+}
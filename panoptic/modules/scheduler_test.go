@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Luismorlan/newsmux/protocol"
 	"github.com/Luismorlan/newsmux/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -123,6 +124,121 @@ func TestUpsertJobs_UpdateOnlyConfig(t *testing.T) {
 			GetRoutinely().EveryMilliseconds, int64(100))
 }
 
+func TestUpsertJobs_ChangedConfigResumesPausedJob(t *testing.T) {
+	s := &Scheduler{
+		m: sync.RWMutex{},
+		Jobs: []*SchedulerJob{
+			GetCustomizedSchedulerJob(t, TestConfig1),
+			GetCustomizedSchedulerJob(t, TestConfig2),
+		},
+	}
+	for _, job := range s.Jobs {
+		job.pausedBy = PausedByFailures
+		job.consecutiveFailures = 5
+	}
+
+	changed := GetCustomizedSchedulerJob(t, TestConfig1)
+	changed.panopticConfig.DryRun = true
+	s.UpsertJobs([]*SchedulerJob{changed, GetCustomizedSchedulerJob(t, TestConfig2)})
+
+	assert.False(t, s.Jobs[0].IsPaused())
+	assert.Equal(t, int32(0), s.Jobs[0].consecutiveFailures)
+	// Unchanged config stays paused.
+	assert.True(t, s.Jobs[1].IsPaused())
+}
+
+func TestUpsertJobs_ChangedConfigKeepsAdminPause(t *testing.T) {
+	s := &Scheduler{
+		m:    sync.RWMutex{},
+		Jobs: []*SchedulerJob{GetCustomizedSchedulerJob(t, TestConfig1)},
+	}
+	s.Jobs[0].Pause("by admin")
+
+	changed := GetCustomizedSchedulerJob(t, TestConfig1)
+	changed.panopticConfig.DryRun = true
+	s.UpsertJobs([]*SchedulerJob{changed})
+
+	assert.True(t, s.Jobs[0].IsPaused())
+	assert.Equal(t, string(PausedByAdmin), s.Jobs[0].Status().PausedBy)
+}
+
+func TestRecordJobResult(t *testing.T) {
+	s := &Scheduler{
+		m: sync.RWMutex{},
+		Jobs: []*SchedulerJob{
+			GetCustomizedSchedulerJob(t, TestConfig1),
+			GetCustomizedSchedulerJob(t, TestConfig2),
+		},
+	}
+	s.Jobs[0].panopticConfig.FailurePolicy = &protocol.FailurePolicy{PauseAfterConsecutiveFailures: 1}
+
+	s.RecordJobResult(&protocol.PanopticJob{
+		Tasks: []*protocol.PanopticTask{
+			{TaskMetadata: &protocol.TaskMetadata{ConfigName: "cfg_1", ResultState: protocol.TaskMetadata_STATE_FAILURE}},
+			{TaskMetadata: &protocol.TaskMetadata{ConfigName: "cfg_2", ResultState: protocol.TaskMetadata_STATE_SUCCESS}},
+			{TaskMetadata: &protocol.TaskMetadata{ConfigName: "unknown", ResultState: protocol.TaskMetadata_STATE_FAILURE}},
+		},
+	})
+
+	statuses := s.JobStatuses()
+	assert.True(t, statuses[0].Paused)
+	assert.Equal(t, int32(1), statuses[0].ConsecutiveFailures)
+	assert.False(t, statuses[1].Paused)
+	assert.Equal(t, int32(0), statuses[1].ConsecutiveFailures)
+
+	// Paused job is skipped.
+	doer := &PrinterJobDoer{}
+	s.Doer = doer
+	s.DoSingleJob(s.Jobs[0])
+	assert.Equal(t, int64(0), s.Jobs[0].runCount)
+}
+
+func TestRecordJobResult_SkipDryRun(t *testing.T) {
+	s := &Scheduler{
+		m:    sync.RWMutex{},
+		Jobs: []*SchedulerJob{GetCustomizedSchedulerJob(t, TestConfig1)},
+	}
+	s.Jobs[0].panopticConfig.FailurePolicy = &protocol.FailurePolicy{PauseAfterConsecutiveFailures: 1}
+
+	s.RecordJobResult(&protocol.PanopticJob{
+		Tasks: []*protocol.PanopticTask{
+			{TaskMetadata: &protocol.TaskMetadata{ConfigName: "cfg_1", DryRun: true, ResultState: protocol.TaskMetadata_STATE_FAILURE}},
+		},
+	})
+
+	assert.False(t, s.Jobs[0].IsPaused())
+	assert.Equal(t, int32(0), s.Jobs[0].Status().ConsecutiveFailures)
+}
+
+func TestRecordJobResult_Deferred(t *testing.T) {
+	s := &Scheduler{
+		m:    sync.RWMutex{},
+		Jobs: []*SchedulerJob{GetCustomizedSchedulerJob(t, TestConfig1)},
+	}
+	job := s.Jobs[0]
+	job.panopticConfig.TaskSchedule.GetRoutinely().EveryMilliseconds = time.Hour.Milliseconds()
+	job.UpdateLastAndNextTime()
+
+	s.RecordJobResult(&protocol.PanopticJob{
+		Tasks: []*protocol.PanopticTask{
+			{TaskMetadata: &protocol.TaskMetadata{ConfigName: "cfg_1", ResultState: protocol.TaskMetadata_STATE_DEFERRED, RetryAfterMilliseconds: 1000}},
+		},
+	})
+
+	// Not a failure, the job runs again once the domain has budget.
+	assert.Equal(t, int32(0), job.Status().ConsecutiveFailures)
+	assert.LessOrEqual(t, int64(job.DurationTillNextRun()), int64(time.Second))
+	select {
+	case <-job.rescheduled:
+	default:
+		t.Fatal("schedule loop is not notified")
+	}
+
+	// A later retry doesn't delay the next run.
+	job.Defer(2 * time.Hour)
+	assert.LessOrEqual(t, int64(job.DurationTillNextRun()), int64(time.Second))
+}
+
 func TestValidateJobs_DuplicateName(t *testing.T) {
 	jobs := []*SchedulerJob{
 		GetCustomizedSchedulerJob(t, TestConfig1),
@@ -0,0 +1,295 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/panoptic"
+	"github.com/Luismorlan/newsmux/protocol"
+	Logger "github.com/Luismorlan/newsmux/utils/log"
+)
+
+const (
+	defaultSilenceCheckInterval       = 10 * time.Minute
+	defaultSilenceWindow              = 3 * time.Hour
+	defaultSilenceLookback            = 14 * 24 * time.Hour
+	defaultSilenceMinBaselineRuns     = 10
+	defaultSilenceMinRecentRuns       = 2
+	defaultSilenceMinExpectedMessages = 5
+	defaultErrorRateJump              = 0.3
+	defaultMinErrorRateMessages       = 20
+)
+
+type SilenceDetectorConfig struct {
+	Name string
+
+	// How often configs are checked.
+	CheckInterval time.Duration
+
+	// Recent runs within the window are compared with history of the same
+	// hours of day.
+	Window time.Duration
+
+	// How far back history is learned from.
+	Lookback time.Duration
+
+	// A config is only judged if it has at least this many runs in history of
+	// the same hours of day, and in the window.
+	MinBaselineRuns int
+	MinRecentRuns   int
+
+	// A config collecting nothing in the window is silent only if history
+	// expects at least this many messages, so that low volume configs don't
+	// alert.
+	MinExpectedMessages float64
+
+	// Alert if ratio of failed and skipped messages in the window exceeds the
+	// usual ratio by this much, given at least MinErrorRateMessages messages.
+	ErrorRateJump        float64
+	MinErrorRateMessages int
+}
+
+func (c SilenceDetectorConfig) withDefaults() SilenceDetectorConfig {
+	if c.CheckInterval <= 0 {
+		c.CheckInterval = defaultSilenceCheckInterval
+	}
+	if c.Window <= 0 {
+		c.Window = defaultSilenceWindow
+	}
+	if c.Lookback <= 0 {
+		c.Lookback = defaultSilenceLookback
+	}
+	if c.MinBaselineRuns <= 0 {
+		c.MinBaselineRuns = defaultSilenceMinBaselineRuns
+	}
+	if c.MinRecentRuns <= 0 {
+		c.MinRecentRuns = defaultSilenceMinRecentRuns
+	}
+	if c.MinExpectedMessages <= 0 {
+		c.MinExpectedMessages = defaultSilenceMinExpectedMessages
+	}
+	if c.ErrorRateJump <= 0 {
+		c.ErrorRateJump = defaultErrorRateJump
+	}
+	if c.MinErrorRateMessages <= 0 {
+		c.MinErrorRateMessages = defaultMinErrorRateMessages
+	}
+	return c
+}
+
+// TaskRunStats aggregates task runs of a config, within an hour of day (UTC)
+// for history.
+type TaskRunStats struct {
+	ConfigName string
+	Hour       int
+	Runs       int
+	Collected  int
+	Failed     int
+	Skipped    int
+}
+
+func (s *TaskRunStats) add(other *TaskRunStats) {
+	s.Runs += other.Runs
+	s.Collected += other.Collected
+	s.Failed += other.Failed
+	s.Skipped += other.Skipped
+}
+
+// Ratio of failed and skipped messages among all messages.
+func (s *TaskRunStats) errorRate() float64 {
+	bad := s.Failed + s.Skipped
+	if bad+s.Collected == 0 {
+		return 0
+	}
+	return float64(bad) / float64(bad+s.Collected)
+}
+
+// SilenceDetector learns the usual message rate of each config by hour of day
+// from persisted task history, and alerts when a config goes quiet or its
+// error rate jumps. The most common silent failure is a collector that keeps
+// succeeding with zero messages after the site changed.
+type SilenceDetector struct {
+	panoptic.Module
+
+	Config SilenceDetectorConfig
+
+	DB *gorm.DB
+
+	EventBus panoptic.EventBus
+
+	// If set, configs are only checked while this instance is leader, so that
+	// an incident is alerted once among instances. A new leader alerts
+	// ongoing incidents once more since it doesn't know they're alerted.
+	Elector *LeaderElector
+
+	m sync.Mutex
+	// Configs currently alerted, cleared once they recover.
+	alerted map[string]protocol.PanopticAlert_AlertType
+}
+
+func NewSilenceDetector(config SilenceDetectorConfig, db *gorm.DB, e panoptic.EventBus) *SilenceDetector {
+	return &SilenceDetector{
+		Config:   config.withDefaults(),
+		DB:       db,
+		EventBus: e,
+		alerted:  map[string]protocol.PanopticAlert_AlertType{},
+	}
+}
+
+// Hours of day (UTC) covered by [start, end).
+func hoursOfDay(start time.Time, end time.Time) map[int]bool {
+	hours := map[int]bool{}
+	for t := start.UTC().Truncate(time.Hour); t.Before(end); t = t.Add(time.Hour) {
+		hours[t.Hour()] = true
+		if len(hours) == 24 {
+			break
+		}
+	}
+	return hours
+}
+
+func (d *SilenceDetector) loadStats(from time.Time, to time.Time, byHour bool) ([]*TaskRunStats, error) {
+	hour := "0"
+	group := "config_name"
+	if byHour {
+		hour = "CAST(EXTRACT(HOUR FROM start_time AT TIME ZONE 'UTC') AS INTEGER)"
+		group = "config_name, hour"
+	}
+	stats := []*TaskRunStats{}
+	err := d.DB.Model(&model.PanopticTaskRun{}).
+		Select(fmt.Sprintf(`config_name, %s AS hour, COUNT(*) AS runs,
+			SUM(total_message_collected) AS collected,
+			SUM(total_message_failed) AS failed,
+			SUM(total_message_skipped) AS skipped`, hour)).
+		Where("start_time >= ? AND start_time < ?", from, to).
+		Group(group).
+		Scan(&stats).Error
+	return stats, err
+}
+
+// Check compares runs within the window ending at now with history, and
+// publishes alerts for configs turning abnormal or recovering.
+func (d *SilenceDetector) Check(now time.Time) error {
+	windowStart := now.Add(-d.Config.Window)
+	history, err := d.loadStats(windowStart.Add(-d.Config.Lookback), windowStart, true)
+	if err != nil {
+		return err
+	}
+	recent, err := d.loadStats(windowStart, now, false)
+	if err != nil {
+		return err
+	}
+	for _, alert := range d.Evaluate(history, recent, hoursOfDay(windowStart, now)) {
+		PublishAlert(d.EventBus, alert)
+	}
+	return nil
+}
+
+// Evaluate returns alerts of configs whose recent stats diverge from history
+// of the given hours of day, and of alerted configs back to normal. Configs
+// without recent runs are left as is.
+func (d *SilenceDetector) Evaluate(history []*TaskRunStats, recent []*TaskRunStats, hours map[int]bool) []*protocol.PanopticAlert {
+	baselines := map[string]*TaskRunStats{}
+	for _, stats := range history {
+		if !hours[stats.Hour] {
+			continue
+		}
+		baseline, ok := baselines[stats.ConfigName]
+		if !ok {
+			baseline = &TaskRunStats{ConfigName: stats.ConfigName}
+			baselines[stats.ConfigName] = baseline
+		}
+		baseline.add(stats)
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+
+	alerts := []*protocol.PanopticAlert{}
+	for _, stats := range recent {
+		baseline, ok := baselines[stats.ConfigName]
+		if !ok || baseline.Runs < d.Config.MinBaselineRuns || stats.Runs < d.Config.MinRecentRuns {
+			continue
+		}
+
+		alertType := protocol.PanopticAlert_ALERT_UNSPECIFIED
+		var message string
+		expected := float64(baseline.Collected) / float64(baseline.Runs) * float64(stats.Runs)
+		if stats.Collected == 0 && expected >= d.Config.MinExpectedMessages {
+			alertType = protocol.PanopticAlert_ALERT_CONFIG_SILENT
+			message = fmt.Sprintf("collected no message in %d runs of last %s, usually %.1f",
+				stats.Runs, d.Config.Window, expected)
+		} else if stats.Collected+stats.Failed+stats.Skipped >= d.Config.MinErrorRateMessages &&
+			stats.errorRate()-baseline.errorRate() >= d.Config.ErrorRateJump {
+			alertType = protocol.PanopticAlert_ALERT_CONFIG_ERROR_RATE
+			message = fmt.Sprintf("failed or skipped %.0f%% messages in last %s, usually %.0f%%",
+				stats.errorRate()*100, d.Config.Window, baseline.errorRate()*100)
+		}
+
+		previous, alerted := d.alerted[stats.ConfigName]
+		if alertType == protocol.PanopticAlert_ALERT_UNSPECIFIED {
+			if alerted {
+				delete(d.alerted, stats.ConfigName)
+				alerts = append(alerts, newDetectorAlert(protocol.PanopticAlert_ALERT_CONFIG_RECOVERED,
+					stats.ConfigName, "is back to normal after "+previous.String()))
+			}
+			continue
+		}
+		if alerted && previous == alertType {
+			continue
+		}
+		d.alerted[stats.ConfigName] = alertType
+		alerts = append(alerts, newDetectorAlert(alertType, stats.ConfigName, message))
+	}
+	return alerts
+}
+
+func newDetectorAlert(alertType protocol.PanopticAlert_AlertType, configName string, message string) *protocol.PanopticAlert {
+	return &protocol.PanopticAlert{
+		Type:       alertType,
+		ConfigName: configName,
+		Message:    fmt.Sprintf("config %s %s", configName, message),
+		Time:       timestamppb.Now(),
+	}
+}
+
+func (d *SilenceDetector) RunModule(ctx context.Context) error {
+	ticker := time.NewTicker(d.Config.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case now := <-ticker.C:
+			if !d.isActive() {
+				// Forget alerts while following, the leader owns them now.
+				d.m.Lock()
+				d.alerted = map[string]protocol.PanopticAlert_AlertType{}
+				d.m.Unlock()
+				continue
+			}
+			if err := d.Check(now); err != nil {
+				Logger.Log.Errorf("fail to check config silence: %v", err)
+			}
+		}
+	}
+}
+
+func (d *SilenceDetector) isActive() bool {
+	return d.Elector == nil || d.Elector.IsLeader()
+}
+
+func (d *SilenceDetector) Name() string {
+	return d.Config.Name
+}
+
+func (d *SilenceDetector) Shutdown() {
+	Logger.Log.Infoln("Module ", d.Config.Name, " gracefully shutdown")
+}
@@ -0,0 +1,67 @@
+package modules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Luismorlan/newsmux/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoursOfDay(t *testing.T) {
+	start := time.Date(2021, 10, 1, 22, 30, 0, 0, time.UTC)
+	assert.Equal(t, map[int]bool{22: true, 23: true, 0: true, 1: true}, hoursOfDay(start, start.Add(3*time.Hour)))
+	assert.Equal(t, 24, len(hoursOfDay(start, start.Add(48*time.Hour))))
+}
+
+func TestSilenceDetectorEvaluate(t *testing.T) {
+	d := NewSilenceDetector(SilenceDetectorConfig{Name: "silence_detector"}, nil, nil)
+	hours := map[int]bool{9: true, 10: true}
+	history := []*TaskRunStats{
+		{ConfigName: "jinshi", Hour: 9, Runs: 20, Collected: 200},
+		{ConfigName: "jinshi", Hour: 10, Runs: 20, Collected: 200},
+		// Quiet at night, shouldn't affect the baseline.
+		{ConfigName: "jinshi", Hour: 3, Runs: 20, Collected: 0},
+		{ConfigName: "weibo", Hour: 9, Runs: 20, Collected: 180, Failed: 10, Skipped: 10},
+		// Too few messages to expect any in the window.
+		{ConfigName: "quiet", Hour: 9, Runs: 20, Collected: 2},
+	}
+
+	alerts := d.Evaluate(history, []*TaskRunStats{
+		{ConfigName: "jinshi", Runs: 6, Collected: 0},
+		{ConfigName: "weibo", Runs: 6, Collected: 20, Failed: 20, Skipped: 10},
+		{ConfigName: "quiet", Runs: 6, Collected: 0},
+		{ConfigName: "unknown", Runs: 6, Collected: 0},
+	}, hours)
+	assert.Equal(t, 2, len(alerts))
+	assert.Equal(t, protocol.PanopticAlert_ALERT_CONFIG_SILENT, alerts[0].Type)
+	assert.Equal(t, "jinshi", alerts[0].ConfigName)
+	assert.Equal(t, protocol.PanopticAlert_ALERT_CONFIG_ERROR_RATE, alerts[1].Type)
+	assert.Equal(t, "weibo", alerts[1].ConfigName)
+
+	// Alert only once while config stays abnormal, and no change for config
+	// without recent runs.
+	alerts = d.Evaluate(history, []*TaskRunStats{
+		{ConfigName: "jinshi", Runs: 6, Collected: 0},
+	}, hours)
+	assert.Equal(t, 0, len(alerts))
+
+	alerts = d.Evaluate(history, []*TaskRunStats{
+		{ConfigName: "jinshi", Runs: 6, Collected: 50},
+		{ConfigName: "weibo", Runs: 6, Collected: 50, Failed: 5},
+	}, hours)
+	assert.Equal(t, 2, len(alerts))
+	assert.Equal(t, protocol.PanopticAlert_ALERT_CONFIG_RECOVERED, alerts[0].Type)
+	assert.Equal(t, protocol.PanopticAlert_ALERT_CONFIG_RECOVERED, alerts[1].Type)
+}
+
+func TestSilenceDetectorOnlyChecksWhileLeading(t *testing.T) {
+	d := NewSilenceDetector(SilenceDetectorConfig{Name: "silence_detector"}, nil, nil)
+	assert.True(t, d.isActive())
+
+	elector, err := NewLeaderElector(LeaderElectorConfig{Name: "leader_elector"}, nil)
+	require.Nil(t, err)
+	d.Elector = elector
+	assert.False(t, d.isActive())
+}
@@ -0,0 +1,132 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/panoptic"
+	Logger "github.com/Luismorlan/newsmux/utils/log"
+)
+
+const statusServerShutdownTimeout = 5 * time.Second
+
+type StatusServerConfig struct {
+	Name string
+	// Address to listen on, e.g. ":8090".
+	Addr string
+}
+
+// StatusServer serves read only status of other modules over http, so that
+// operators can tell e.g. whether a config change is picked up.
+type StatusServer struct {
+	panoptic.Module
+
+	Config StatusServerConfig
+
+	Mux *http.ServeMux
+
+	server *http.Server
+}
+
+// NewStatusServer serves scheduler status, and crawler health from persisted
+// task history if db is not nil.
+func NewStatusServer(config StatusServerConfig, scheduler *Scheduler, db *gorm.DB) *StatusServer {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status/config", func(w http.ResponseWriter, r *http.Request) {
+		WriteJSONStatus(w, scheduler.ConfigStatus())
+	})
+	mux.HandleFunc("/status/jobs", func(w http.ResponseWriter, r *http.Request) {
+		WriteJSONStatus(w, scheduler.JobStatuses())
+	})
+	mux.HandleFunc("/status/health", func(w http.ResponseWriter, r *http.Request) {
+		HandleHealthStatus(w, r, db)
+	})
+	return &StatusServer{
+		Config: config,
+		Mux:    mux,
+		server: &http.Server{Addr: config.Addr, Handler: mux},
+	}
+}
+
+// HandleHealthStatus serves health of all configs, computed on runs within
+// query param "window" (e.g. "6h", defaults to 24h, bounded by task history
+// retention). If query param "config" is set, only that config is returned
+// with its latest "limit" runs.
+func HandleHealthStatus(w http.ResponseWriter, r *http.Request, db *gorm.DB) {
+	if db == nil {
+		http.Error(w, "task history is not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	query := r.URL.Query()
+	window := defaultHealthWindow
+	if v := query.Get("window"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			http.Error(w, "invalid window", http.StatusBadRequest)
+			return
+		}
+		window = d
+	}
+	limit := defaultRecentRunsLimit
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			http.Error(w, "invalid limit", http.StatusBadRequest)
+			return
+		}
+		limit = n
+	}
+
+	configName := query.Get("config")
+	healths, err := GetConfigHealths(db, configName, time.Now().Add(-window))
+	if err != nil {
+		Logger.Log.Errorf("fail to get config health: %v", err)
+		http.Error(w, "fail to get config health", http.StatusInternalServerError)
+		return
+	}
+	if configName != "" {
+		for _, health := range healths {
+			if health.RecentRuns, err = GetRecentTaskRuns(db, configName, limit); err != nil {
+				Logger.Log.Errorf("fail to get recent runs of %s: %v", configName, err)
+				http.Error(w, "fail to get recent runs", http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+	WriteJSONStatus(w, healths)
+}
+
+// WriteJSONStatus writes v as the JSON response body.
+func WriteJSONStatus(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		Logger.Log.Errorf("fail to write status response: %v", err)
+	}
+}
+
+func (s *StatusServer) RunModule(ctx context.Context) error {
+	Logger.Log.Infoln("status server listening on", s.Config.Addr)
+	if err := s.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+func (s *StatusServer) Name() string {
+	return s.Config.Name
+}
+
+func (s *StatusServer) Shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), statusServerShutdownTimeout)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		Logger.Log.Errorf("fail to shutdown status server: %v", err)
+	}
+	Logger.Log.Infoln("Module ", s.Config.Name, " gracefully shutdown")
+}
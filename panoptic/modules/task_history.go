@@ -0,0 +1,144 @@
+package modules
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/protocol"
+)
+
+const (
+	// Window of runs that success rate and messages per run are computed on.
+	defaultHealthWindow = 24 * time.Hour
+
+	defaultRecentRunsLimit = 20
+	maxRecentRunsLimit     = 200
+
+	// Task runs older than retention are pruned, it must cover the silence
+	// detector's lookback.
+	DefaultTaskRunRetention = 30 * 24 * time.Hour
+	taskRunPruneInterval    = time.Hour
+)
+
+// ConfigHealth summarizes persisted task runs of a config within the health
+// window, last run times are null if there's no such run in the window.
+type ConfigHealth struct {
+	ConfigName string `json:"configName"`
+	// Number of runs, successful runs, success rate and average collected
+	// messages per run.
+	Runs            int        `json:"runs"`
+	SuccessRuns     int        `json:"successRuns"`
+	SuccessRate     float64    `json:"successRate"`
+	AverageMessages float64    `json:"averageMessages"`
+	LastRunAt       *time.Time `json:"lastRunAt"`
+	LastSuccessAt   *time.Time `json:"lastSuccessAt"`
+	// Last run that collected at least one message, a config succeeding with
+	// zero messages for long usually means the crawler is broken.
+	LastNonZeroRunAt *time.Time `json:"lastNonZeroRunAt"`
+
+	RecentRuns []*model.PanopticTaskRun `json:"recentRuns,omitempty" gorm:"-"`
+}
+
+// NewPanopticTaskRun converts an executed task into its persisted form.
+func NewPanopticTaskRun(job *protocol.PanopticJob, task *protocol.PanopticTask) *model.PanopticTaskRun {
+	run := &model.PanopticTaskRun{
+		Id:              task.TaskId,
+		JobId:           job.JobId,
+		DataCollectorId: task.DataCollectorId.String(),
+	}
+	meta := task.TaskMetadata
+	if meta == nil {
+		run.ResultState = protocol.TaskMetadata_STATE_UNSPECIFIED.String()
+		return run
+	}
+	run.ConfigName = meta.ConfigName
+	run.IpAddr = meta.IpAddr
+	run.ResultState = meta.ResultState.String()
+	run.TotalMessageCollected = int(meta.TotalMessageCollected)
+	run.TotalMessageFailed = int(meta.TotalMessageFailed)
+	run.TotalMessageSkipped = int(meta.TotalMessageSkipped)
+	if meta.TaskStartTime != nil {
+		run.StartTime = meta.TaskStartTime.AsTime()
+	}
+	if meta.TaskEndTime != nil {
+		run.EndTime = meta.TaskEndTime.AsTime()
+	}
+	return run
+}
+
+// SaveTaskRuns persists all tasks of an executed job. A task reported twice
+// (e.g. the job is redelivered) is only stored once.
+func SaveTaskRuns(db *gorm.DB, job *protocol.PanopticJob) error {
+	runs := []*model.PanopticTaskRun{}
+	for _, task := range job.Tasks {
+		if task.TaskId == "" {
+			continue
+		}
+		runs = append(runs, NewPanopticTaskRun(job, task))
+	}
+	if len(runs) == 0 {
+		return nil
+	}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).Create(&runs).Error
+}
+
+// PruneTaskRuns deletes task runs started before the given time, returns the
+// number of deleted runs.
+func PruneTaskRuns(db *gorm.DB, before time.Time) (int64, error) {
+	res := db.Where("start_time < ?", before).Delete(&model.PanopticTaskRun{})
+	return res.RowsAffected, res.Error
+}
+
+// GetConfigHealths returns health of every config with runs since the given
+// time, or of a single config if configName is not empty. Deferred runs are
+// only listed in recent runs.
+func GetConfigHealths(db *gorm.DB, configName string, since time.Time) ([]*ConfigHealth, error) {
+	success := protocol.TaskMetadata_STATE_SUCCESS.String()
+	query := db.Model(&model.PanopticTaskRun{}).
+		Select(`config_name,
+			COUNT(*) AS runs,
+			COUNT(*) FILTER (WHERE result_state = ?) AS success_runs,
+			COALESCE(AVG(total_message_collected), 0) AS average_messages,
+			MAX(start_time) AS last_run_at,
+			MAX(start_time) FILTER (WHERE result_state = ?) AS last_success_at,
+			MAX(start_time) FILTER (WHERE total_message_collected > 0) AS last_non_zero_run_at`,
+			success, success).
+		Where("start_time >= ?", since).
+		// Deferred runs are not executed.
+		Where("result_state <> ?", protocol.TaskMetadata_STATE_DEFERRED.String()).
+		Group("config_name").
+		Order("config_name")
+	if configName != "" {
+		query = query.Where("config_name = ?", configName)
+	}
+
+	healths := []*ConfigHealth{}
+	if err := query.Scan(&healths).Error; err != nil {
+		return nil, err
+	}
+	for _, health := range healths {
+		if health.Runs > 0 {
+			health.SuccessRate = float64(health.SuccessRuns) / float64(health.Runs)
+		}
+	}
+	return healths, nil
+}
+
+// GetRecentTaskRuns returns the latest runs of a config, newest first.
+func GetRecentTaskRuns(db *gorm.DB, configName string, limit int) ([]*model.PanopticTaskRun, error) {
+	if limit <= 0 {
+		limit = defaultRecentRunsLimit
+	}
+	if limit > maxRecentRunsLimit {
+		limit = maxRecentRunsLimit
+	}
+	runs := []*model.PanopticTaskRun{}
+	err := db.Where("config_name = ?", configName).
+		Order("start_time desc").
+		Limit(limit).
+		Find(&runs).Error
+	return runs, err
+}
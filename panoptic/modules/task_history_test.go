@@ -0,0 +1,81 @@
+package modules
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/protocol"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNewPanopticTaskRun(t *testing.T) {
+	start := time.Date(2021, 10, 1, 8, 0, 0, 0, time.UTC)
+	job := &protocol.PanopticJob{
+		JobId: "job_1",
+		Tasks: []*protocol.PanopticTask{
+			{
+				TaskId:          "task_1",
+				DataCollectorId: protocol.PanopticTask_COLLECTOR_JINSHI,
+				TaskMetadata: &protocol.TaskMetadata{
+					ConfigName:            "jinshi",
+					TaskStartTime:         timestamppb.New(start),
+					TaskEndTime:           timestamppb.New(start.Add(time.Second)),
+					TotalMessageCollected: 10,
+					TotalMessageFailed:    1,
+					TotalMessageSkipped:   2,
+					IpAddr:                "1.2.3.4",
+					ResultState:           protocol.TaskMetadata_STATE_SUCCESS,
+				},
+			},
+			{TaskId: "task_2"},
+		},
+	}
+
+	run := NewPanopticTaskRun(job, job.Tasks[0])
+	assert.Equal(t, "task_1", run.Id)
+	assert.Equal(t, "job_1", run.JobId)
+	assert.Equal(t, "jinshi", run.ConfigName)
+	assert.Equal(t, "COLLECTOR_JINSHI", run.DataCollectorId)
+	assert.Equal(t, "STATE_SUCCESS", run.ResultState)
+	assert.Equal(t, "1.2.3.4", run.IpAddr)
+	assert.Equal(t, 10, run.TotalMessageCollected)
+	assert.Equal(t, 1, run.TotalMessageFailed)
+	assert.Equal(t, 2, run.TotalMessageSkipped)
+	assert.True(t, start.Equal(run.StartTime))
+	assert.True(t, start.Add(time.Second).Equal(run.EndTime))
+
+	// Task without metadata, e.g. failed before execution.
+	run = NewPanopticTaskRun(job, job.Tasks[1])
+	assert.Equal(t, "task_2", run.Id)
+	assert.Equal(t, "STATE_UNSPECIFIED", run.ResultState)
+	assert.True(t, run.StartTime.IsZero())
+}
+
+func TestHandleHealthStatusWithoutDB(t *testing.T) {
+	w := httptest.NewRecorder()
+	HandleHealthStatus(w, httptest.NewRequest(http.MethodGet, "/status/health", nil), nil)
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+// Health is served along with scheduler status, which uses camelCase keys.
+func TestConfigHealthJsonKeys(t *testing.T) {
+	data, err := json.Marshal(&ConfigHealth{
+		ConfigName: "jinshi",
+		RecentRuns: []*model.PanopticTaskRun{{Id: "task_1", ConfigName: "jinshi"}},
+	})
+	assert.Nil(t, err)
+
+	var health map[string]interface{}
+	assert.Nil(t, json.Unmarshal(data, &health))
+	assert.Equal(t, "jinshi", health["configName"])
+	assert.Contains(t, health, "successRuns")
+	assert.Contains(t, health, "lastNonZeroRunAt")
+	runs := health["recentRuns"].([]interface{})
+	assert.Equal(t, "task_1", runs[0].(map[string]interface{})["id"])
+	assert.Equal(t, "jinshi", runs[0].(map[string]interface{})["configName"])
+}
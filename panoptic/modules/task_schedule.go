@@ -0,0 +1,201 @@
+package modules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/Luismorlan/newsmux/protocol"
+)
+
+const (
+	holidayDateLayout   = "2006-01-02"
+	timeOfDayLayout     = "15:04"
+	maxHolidaySkipDays  = 366
+	windowLookAheadDays = 31
+)
+
+// NextRunTime returns the time a job with the schedule should run next, after
+// t.
+func NextRunTime(schedule *protocol.TaskSchedule, t time.Time) (time.Time, error) {
+	if schedule == nil {
+		return time.Time{}, fmt.Errorf("missing task schedule")
+	}
+
+	switch scheduleType := schedule.Schedule.(type) {
+	case *protocol.TaskSchedule_Routinely:
+		return t.Add(time.Duration(schedule.GetRoutinely().EveryMilliseconds) * time.Millisecond), nil
+	case *protocol.TaskSchedule_Cron:
+		return nextCronTime(schedule.GetCron(), schedule.GetHolidayCalendar(), t)
+	case *protocol.TaskSchedule_TimeWindows:
+		return nextTimeWindowsTime(schedule.GetTimeWindows(), schedule.GetHolidayCalendar(), t)
+	default:
+		return time.Time{}, fmt.Errorf("unknown schedule type: %T", scheduleType)
+	}
+}
+
+func loadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(name)
+}
+
+// holidays indexes holiday dates by their "YYYY-MM-DD" format.
+type holidays map[string]bool
+
+func parseHolidays(calendar *protocol.HolidayCalendar) (holidays, error) {
+	res := holidays{}
+	for _, date := range calendar.GetDates() {
+		if _, err := time.Parse(holidayDateLayout, date); err != nil {
+			return nil, fmt.Errorf("invalid holiday date %s: %w", date, err)
+		}
+		res[date] = true
+	}
+	return res, nil
+}
+
+// contains reports whether t is on a holiday, in t's location.
+func (h holidays) contains(t time.Time) bool {
+	return h[t.Format(holidayDateLayout)]
+}
+
+func nextCronTime(c *protocol.Cron, calendar *protocol.HolidayCalendar, t time.Time) (time.Time, error) {
+	loc, err := loadLocation(c.TimeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// Without explicit time zone, robfig/cron evaluates in time.Local.
+	spec, err := cron.ParseStandard(fmt.Sprintf("CRON_TZ=%s %s", loc.String(), c.Expression))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid cron expression %q: %w", c.Expression, err)
+	}
+	days, err := parseHolidays(calendar)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	next := spec.Next(t)
+	for !next.IsZero() && days.contains(next.In(loc)) {
+		if next.Sub(t) > maxHolidaySkipDays*24*time.Hour {
+			break
+		}
+		// Skip the rest of the holiday.
+		y, m, d := next.In(loc).Date()
+		next = spec.Next(time.Date(y, m, d+1, 0, 0, 0, 0, loc).Add(-time.Nanosecond))
+	}
+	if next.IsZero() || days.contains(next.In(loc)) {
+		return time.Time{}, fmt.Errorf("cron expression %q never fires", c.Expression)
+	}
+	return next, nil
+}
+
+// A TimeWindow parsed into offsets since midnight.
+type timeWindow struct {
+	start    time.Duration
+	end      time.Duration
+	days     map[time.Weekday]bool
+	interval time.Duration
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse(timeOfDayLayout, s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %s: %w", s, err)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func parseTimeWindow(w *protocol.TimeWindow) (*timeWindow, error) {
+	start, err := parseTimeOfDay(w.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseTimeOfDay(w.End)
+	if err != nil {
+		return nil, err
+	}
+	if start >= end {
+		return nil, fmt.Errorf("time window start %s must be before end %s", w.Start, w.End)
+	}
+	if w.EveryMilliseconds <= 0 {
+		return nil, fmt.Errorf("time window %s-%s must have positive interval", w.Start, w.End)
+	}
+	days := map[time.Weekday]bool{}
+	for _, day := range w.DaysOfWeek {
+		if day < 0 || day > 6 {
+			return nil, fmt.Errorf("invalid day of week %d", day)
+		}
+		days[time.Weekday(day)] = true
+	}
+	return &timeWindow{
+		start:    start,
+		end:      end,
+		days:     days,
+		interval: time.Duration(w.EveryMilliseconds) * time.Millisecond,
+	}, nil
+}
+
+// appliesOn reports whether the window is active on the day of midnight.
+func (w *timeWindow) appliesOn(midnight time.Time, days holidays) bool {
+	if days.contains(midnight) {
+		return false
+	}
+	return len(w.days) == 0 || w.days[midnight.Weekday()]
+}
+
+func nextTimeWindowsTime(tw *protocol.TimeWindows, calendar *protocol.HolidayCalendar, t time.Time) (time.Time, error) {
+	loc, err := loadLocation(tw.TimeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	days, err := parseHolidays(calendar)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if tw.DefaultEveryMilliseconds <= 0 {
+		return time.Time{}, fmt.Errorf("time windows must have positive default interval")
+	}
+	windows := []*timeWindow{}
+	for _, w := range tw.Windows {
+		window, err := parseTimeWindow(w)
+		if err != nil {
+			return time.Time{}, err
+		}
+		windows = append(windows, window)
+	}
+
+	local := t.In(loc)
+	y, m, d := local.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	// Wall clock offset, which differs from local.Sub(today) on DST changes.
+	sinceMidnight := time.Duration(local.Hour())*time.Hour +
+		time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second +
+		time.Duration(local.Nanosecond())
+
+	interval := time.Duration(tw.DefaultEveryMilliseconds) * time.Millisecond
+	for _, w := range windows {
+		if w.appliesOn(today, days) && w.start <= sinceMidnight && sinceMidnight < w.end {
+			interval = w.interval
+			break
+		}
+	}
+	next := t.Add(interval)
+
+	// Don't sleep through the start of a window.
+	for offset := 0; offset <= windowLookAheadDays; offset++ {
+		midnight := time.Date(y, m, d+offset, 0, 0, 0, 0, loc)
+		if midnight.After(next) {
+			break
+		}
+		for _, w := range windows {
+			start := time.Date(y, m, d+offset, 0, int(w.start/time.Minute), 0, 0, loc)
+			if w.appliesOn(midnight, days) && start.After(t) && start.Before(next) {
+				next = start
+			}
+		}
+	}
+	return next, nil
+}
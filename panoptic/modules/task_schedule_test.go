@@ -0,0 +1,149 @@
+package modules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"github.com/Luismorlan/newsmux/protocol"
+)
+
+const (
+	TestCronSchedule = `
+		cron: {
+			expression: "0 9 * * *"
+			time_zone: "Asia/Shanghai"
+		}
+	`
+	// Every 30s during market hours on weekdays, every 10m otherwise.
+	TestMarketHoursSchedule = `
+		time_windows: {
+			time_zone: "Asia/Shanghai"
+			windows: {
+				start: "09:15"
+				end: "15:00"
+				days_of_week: [1, 2, 3, 4, 5]
+				every_milliseconds: 30000
+			}
+			default_every_milliseconds: 600000
+		}
+	`
+	TestHolidayCalendar = `
+		holiday_calendar: {
+			dates: ["2021-12-01"]
+		}
+	`
+)
+
+func parseTaskSchedule(t *testing.T, s string) *protocol.TaskSchedule {
+	schedule := &protocol.TaskSchedule{}
+	require.NoError(t, prototext.Unmarshal([]byte(s), schedule))
+	return schedule
+}
+
+// Time in Asia/Shanghai, 2021-12-01 is a Wednesday.
+func shanghaiTime(t *testing.T, s string) time.Time {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	res, err := time.ParseInLocation("2006-01-02 15:04:05", s, loc)
+	require.NoError(t, err)
+	return res
+}
+
+func TestNextRunTime_Cron(t *testing.T) {
+	schedule := parseTaskSchedule(t, TestCronSchedule)
+
+	next, err := NextRunTime(schedule, shanghaiTime(t, "2021-12-01 08:00:00").In(time.UTC))
+	require.NoError(t, err)
+	assert.True(t, shanghaiTime(t, "2021-12-01 09:00:00").Equal(next))
+
+	next, err = NextRunTime(schedule, shanghaiTime(t, "2021-12-01 09:00:00"))
+	require.NoError(t, err)
+	assert.True(t, shanghaiTime(t, "2021-12-02 09:00:00").Equal(next))
+}
+
+func TestNextRunTime_CronSkipsHolidays(t *testing.T) {
+	schedule := parseTaskSchedule(t, TestCronSchedule+TestHolidayCalendar)
+
+	next, err := NextRunTime(schedule, shanghaiTime(t, "2021-12-01 08:00:00"))
+	require.NoError(t, err)
+	assert.True(t, shanghaiTime(t, "2021-12-02 09:00:00").Equal(next))
+}
+
+func TestNextRunTime_InvalidCron(t *testing.T) {
+	_, err := NextRunTime(parseTaskSchedule(t, `cron: { expression: "not a cron" }`), time.Now())
+	assert.Error(t, err)
+
+	_, err = NextRunTime(parseTaskSchedule(t, `cron: { expression: "0 9 * * *" time_zone: "Mars/Olympus" }`), time.Now())
+	assert.Error(t, err)
+
+	// Feb 30th never comes.
+	_, err = NextRunTime(parseTaskSchedule(t, `cron: { expression: "0 9 30 2 *" }`), time.Now())
+	assert.Error(t, err)
+}
+
+func TestNextRunTime_TimeWindows(t *testing.T) {
+	schedule := parseTaskSchedule(t, TestMarketHoursSchedule)
+
+	testCases := []struct {
+		name     string
+		now      string
+		expected string
+	}{
+		{"in window", "2021-12-01 10:00:00", "2021-12-01 10:00:30"},
+		{"outside window", "2021-12-01 03:00:00", "2021-12-01 03:10:00"},
+		{"run at window start", "2021-12-01 09:10:00", "2021-12-01 09:15:00"},
+		{"window end is exclusive", "2021-12-01 15:00:00", "2021-12-01 15:10:00"},
+		{"weekend", "2021-12-04 10:00:00", "2021-12-04 10:10:00"},
+		{"across midnight", "2021-12-05 23:55:00", "2021-12-06 00:05:00"},
+		{"run at window start on monday", "2021-12-06 09:14:00", "2021-12-06 09:15:00"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next, err := NextRunTime(schedule, shanghaiTime(t, tc.now))
+			require.NoError(t, err)
+			assert.True(t, shanghaiTime(t, tc.expected).Equal(next), "got %s", next)
+		})
+	}
+}
+
+func TestNextRunTime_TimeWindowsOnHoliday(t *testing.T) {
+	schedule := parseTaskSchedule(t, TestMarketHoursSchedule+TestHolidayCalendar)
+
+	next, err := NextRunTime(schedule, shanghaiTime(t, "2021-12-01 10:00:00"))
+	require.NoError(t, err)
+	assert.True(t, shanghaiTime(t, "2021-12-01 10:10:00").Equal(next))
+
+	next, err = NextRunTime(schedule, shanghaiTime(t, "2021-12-01 09:10:00"))
+	require.NoError(t, err)
+	assert.True(t, shanghaiTime(t, "2021-12-01 09:20:00").Equal(next))
+}
+
+func TestNextRunTime_InvalidTimeWindows(t *testing.T) {
+	for _, s := range []string{
+		`time_windows: { windows: { start: "15:00" end: "09:15" every_milliseconds: 1000 } default_every_milliseconds: 1000 }`,
+		`time_windows: { windows: { start: "9am" end: "15:00" every_milliseconds: 1000 } default_every_milliseconds: 1000 }`,
+		`time_windows: { windows: { start: "09:15" end: "15:00" every_milliseconds: 1000 } }`,
+		`time_windows: { windows: { start: "09:15" end: "15:00" days_of_week: [7] every_milliseconds: 1000 } default_every_milliseconds: 1000 }`,
+		`time_windows: { default_every_milliseconds: 1000 } holiday_calendar: { dates: ["2021/12/01"] }`,
+	} {
+		_, err := NextRunTime(parseTaskSchedule(t, s), time.Now())
+		assert.Error(t, err, s)
+	}
+}
+
+func TestValidateJobs_InvalidSchedule(t *testing.T) {
+	job := GetCustomizedSchedulerJob(t, `
+		name: "cfg_cron"
+		data_collector_id: COLLECTOR_JINSHI
+		task_schedule: {
+			cron: {
+				expression: "every minute"
+			}
+		}
+	`)
+	assert.Error(t, ValidateJobs([]*SchedulerJob{job}))
+}
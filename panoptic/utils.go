@@ -1,6 +1,8 @@
 package panoptic
 
 import (
+	"fmt"
+
 	"google.golang.org/protobuf/encoding/prototext"
 	"gorm.io/gorm"
 
@@ -70,3 +72,47 @@ func MergeSubsourcesFromConfigAndDb(db *gorm.DB, configs *protocol.PanopticConfi
 		}
 	}
 }
+
+// Add source level customized crawler configs created by users from DB.
+// A config whose name is already used by another config is renamed with its
+// source id, which is unique, since scheduler rejects the whole batch on
+// duplicate job names and the user's crawler would never run.
+// This function updates configs, returns error if sources can't be queried so
+// that caller doesn't mistake a failed query for no customized configs.
+func MergeCustomizedSourceConfigsFromDb(db *gorm.DB, configs *protocol.PanopticConfigs) error {
+	var sources []model.Source
+	if err := db.Where("customized_crawler_panoptic_config IS NOT NULL").
+		Order("created_at").
+		Find(&sources).Error; err != nil {
+		return err
+	}
+
+	existingNames := map[string]bool{}
+	for _, config := range configs.Config {
+		existingNames[config.Name] = true
+	}
+
+	for _, source := range sources {
+		var config protocol.PanopticConfig
+		if err := prototext.Unmarshal([]byte(*source.CustomizedCrawlerPanopticConfig), &config); err != nil {
+			Logger.Log.Errorf("can't unmarshal customized crawler config for source %s, error %+v", source.Id, err)
+			continue
+		}
+		if existingNames[config.Name] {
+			config.Name = fmt.Sprintf("%s_%s", config.Name, source.Id)
+		}
+		if existingNames[config.Name] {
+			Logger.Log.Errorf("skip customized crawler config for source %s, duplicate config name %s", source.Id, config.Name)
+			continue
+		}
+		// Always crawl into the source the config is stored with.
+		if config.TaskParams == nil {
+			config.TaskParams = &protocol.TaskParams{}
+		}
+		config.TaskParams.SourceId = source.Id
+
+		existingNames[config.Name] = true
+		configs.Config = append(configs.Config, &config)
+	}
+	return nil
+}
@@ -145,3 +145,55 @@ func TestMergeSubsourcesFromConfigAndDb_Twitter(t *testing.T) {
 	require.Equal(t, configs.Config[0].TaskParams.SubSources[0].Name, "贝索斯")
 	require.Equal(t, configs.Config[0].TaskParams.SubSources[1].Name, "马斯克")
 }
+
+func TestMergeCustomizedSourceConfigsFromDb(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+
+	user := model.User{
+		Id:   uuid.New().String(),
+		Name: "test_user",
+	}
+
+	config := `name: "user_customized_source" data_collector_id: COLLECTOR_USER_CUSTOMIZED_SOURCE task_params: {source_id: "stale_id" customized_source_crawler_task_params: {crawl_url: "https://www.cls.cn/telegraph" base_selector: ".telegraph-list"}} task_schedule: {routinely: {every_milliseconds: 300000}}`
+	duplicateConfig := `name: "config_in_github" data_collector_id: COLLECTOR_USER_CUSTOMIZED_SOURCE`
+	sourceId := uuid.New().String()
+	duplicateSourceId := uuid.New().String()
+	db.Create(&model.Source{
+		Id:                              sourceId,
+		Name:                            "customized",
+		CreatedAt:                       time.Now(),
+		Creator:                         user,
+		CustomizedCrawlerPanopticConfig: &config,
+	})
+	db.Create(&model.Source{
+		Id:                              duplicateSourceId,
+		Name:                            "duplicate",
+		CreatedAt:                       time.Now(),
+		Creator:                         user,
+		CustomizedCrawlerPanopticConfig: &duplicateConfig,
+	})
+	db.Create(&model.Source{
+		Id:        uuid.New().String(),
+		Name:      "not_customized",
+		CreatedAt: time.Now(),
+		Creator:   user,
+	})
+
+	configs := protocol.PanopticConfigs{
+		Config: []*protocol.PanopticConfig{{Name: "config_in_github"}},
+	}
+	require.Nil(t, MergeCustomizedSourceConfigsFromDb(db, &configs))
+	require.Len(t, configs.Config, 3)
+	require.Equal(t, "user_customized_source", configs.Config[1].Name)
+	require.Equal(t, sourceId, configs.Config[1].TaskParams.SourceId)
+	require.Equal(t, ".telegraph-list", configs.Config[1].TaskParams.GetCustomizedSourceCrawlerTaskParams().BaseSelector)
+	require.Equal(t, int64(300000), configs.Config[1].TaskSchedule.GetRoutinely().EveryMilliseconds)
+	// Duplicate name is made unique with source id instead of dropped.
+	require.Equal(t, "config_in_github_"+duplicateSourceId, configs.Config[2].Name)
+	require.Equal(t, duplicateSourceId, configs.Config[2].TaskParams.SourceId)
+
+	// A failed query is reported instead of returning no customized configs.
+	conn, _ := db.DB()
+	conn.Close()
+	require.NotNil(t, MergeCustomizedSourceConfigsFromDb(db, &protocol.PanopticConfigs{}))
+}
@@ -41,6 +41,16 @@ type PanopticConfig struct {
 	// true, monitor it in Datadog for a while and then turn it to false to
 	// actually enable it and publish messages into SNS.
 	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// How scheduler reacts to failed executions of this config.
+	FailurePolicy *FailurePolicy `protobuf:"bytes,6,opt,name=failure_policy,json=failurePolicy,proto3" json:"failure_policy,omitempty"`
+	// Target domain this config crawls, e.g. "weibo.com". Tasks of configs
+	// sharing a domain share its request budget, see DOMAIN_BUDGETS in panoptic
+	// app setting. Unlimited if empty.
+	Domain string `protobuf:"bytes,7,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Poll subsources at different intervals by their posting rate, instead of
+	// polling all subsources on the same schedule. Only applies to configs
+	// scheduled routinely.
+	AdaptivePolling *AdaptivePolling `protobuf:"bytes,8,opt,name=adaptive_polling,json=adaptivePolling,proto3" json:"adaptive_polling,omitempty"`
 }
 
 func (x *PanopticConfig) Reset() {
@@ -110,6 +120,177 @@ func (x *PanopticConfig) GetDryRun() bool {
 	return false
 }
 
+func (x *PanopticConfig) GetFailurePolicy() *FailurePolicy {
+	if x != nil {
+		return x.FailurePolicy
+	}
+	return nil
+}
+
+func (x *PanopticConfig) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *PanopticConfig) GetAdaptivePolling() *AdaptivePolling {
+	if x != nil {
+		return x.AdaptivePolling
+	}
+	return nil
+}
+
+// Subsources are grouped into tiers by posting rate observed from stored
+// posts, each tier is scheduled as a separate job named "<name>-tier<i>".
+// Tier intervals grow geometrically from min to max, a subsource is put in the
+// slowest tier that still polls at least once per post it's expected to make.
+// Subsources not stored yet are put in the fastest tier.
+type AdaptivePolling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Interval of the fastest tier. Defaults to the routinely interval of the
+	// config.
+	MinEveryMilliseconds int64 `protobuf:"varint,1,opt,name=min_every_milliseconds,json=minEveryMilliseconds,proto3" json:"min_every_milliseconds,omitempty"`
+	// Interval of the slowest tier. Defaults to 24 hours.
+	MaxEveryMilliseconds int64 `protobuf:"varint,2,opt,name=max_every_milliseconds,json=maxEveryMilliseconds,proto3" json:"max_every_milliseconds,omitempty"`
+	// Number of tiers. Defaults to 3.
+	Tiers int32 `protobuf:"varint,3,opt,name=tiers,proto3" json:"tiers,omitempty"`
+	// Posting rate is observed over this many days. Defaults to 7.
+	LookbackDays int32 `protobuf:"varint,4,opt,name=lookback_days,json=lookbackDays,proto3" json:"lookback_days,omitempty"`
+}
+
+func (x *AdaptivePolling) Reset() {
+	*x = AdaptivePolling{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_config_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdaptivePolling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdaptivePolling) ProtoMessage() {}
+
+func (x *AdaptivePolling) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_config_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdaptivePolling.ProtoReflect.Descriptor instead.
+func (*AdaptivePolling) Descriptor() ([]byte, []int) {
+	return file_panoptic_config_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AdaptivePolling) GetMinEveryMilliseconds() int64 {
+	if x != nil {
+		return x.MinEveryMilliseconds
+	}
+	return 0
+}
+
+func (x *AdaptivePolling) GetMaxEveryMilliseconds() int64 {
+	if x != nil {
+		return x.MaxEveryMilliseconds
+	}
+	return 0
+}
+
+func (x *AdaptivePolling) GetTiers() int32 {
+	if x != nil {
+		return x.Tiers
+	}
+	return 0
+}
+
+func (x *AdaptivePolling) GetLookbackDays() int32 {
+	if x != nil {
+		return x.LookbackDays
+	}
+	return 0
+}
+
+// Consecutive failed executions back off exponentially from the scheduled
+// interval, with jitter. A successful execution resets the backoff.
+type FailurePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disable backoff, keep running at the scheduled interval on failures.
+	DisableBackoff bool `protobuf:"varint,1,opt,name=disable_backoff,json=disableBackoff,proto3" json:"disable_backoff,omitempty"`
+	// Upper bound of the backoff interval. Defaults to 1 hour.
+	MaxBackoffMilliseconds int64 `protobuf:"varint,2,opt,name=max_backoff_milliseconds,json=maxBackoffMilliseconds,proto3" json:"max_backoff_milliseconds,omitempty"`
+	// Pause the config after this many consecutive failures, and emit an alert.
+	// A paused config resumes when it's changed. Never pause if not positive.
+	PauseAfterConsecutiveFailures int32 `protobuf:"varint,3,opt,name=pause_after_consecutive_failures,json=pauseAfterConsecutiveFailures,proto3" json:"pause_after_consecutive_failures,omitempty"`
+}
+
+func (x *FailurePolicy) Reset() {
+	*x = FailurePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailurePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailurePolicy) ProtoMessage() {}
+
+func (x *FailurePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailurePolicy.ProtoReflect.Descriptor instead.
+func (*FailurePolicy) Descriptor() ([]byte, []int) {
+	return file_panoptic_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FailurePolicy) GetDisableBackoff() bool {
+	if x != nil {
+		return x.DisableBackoff
+	}
+	return false
+}
+
+func (x *FailurePolicy) GetMaxBackoffMilliseconds() int64 {
+	if x != nil {
+		return x.MaxBackoffMilliseconds
+	}
+	return 0
+}
+
+func (x *FailurePolicy) GetPauseAfterConsecutiveFailures() int32 {
+	if x != nil {
+		return x.PauseAfterConsecutiveFailures
+	}
+	return 0
+}
+
 type TaskSchedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -122,13 +303,21 @@ type TaskSchedule struct {
 	//
 	// Types that are assignable to Schedule:
 	//	*TaskSchedule_Routinely
+	//	*TaskSchedule_Cron
+	//	*TaskSchedule_TimeWindows
 	Schedule isTaskSchedule_Schedule `protobuf_oneof:"schedule"`
+	// Holidays on which cron doesn't fire and time windows don't apply. Dates
+	// are in the time zone of the schedule. Routinely ignores holidays.
+	HolidayCalendar *HolidayCalendar `protobuf:"bytes,5,opt,name=holiday_calendar,json=holidayCalendar,proto3" json:"holiday_calendar,omitempty"`
+	// Delay each run by a random duration up to this value, so that jobs of
+	// the same schedule don't run in lockstep.
+	JitterMilliseconds int64 `protobuf:"varint,6,opt,name=jitter_milliseconds,json=jitterMilliseconds,proto3" json:"jitter_milliseconds,omitempty"`
 }
 
 func (x *TaskSchedule) Reset() {
 	*x = TaskSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_panoptic_config_proto_msgTypes[1]
+		mi := &file_panoptic_config_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -141,7 +330,7 @@ func (x *TaskSchedule) String() string {
 func (*TaskSchedule) ProtoMessage() {}
 
 func (x *TaskSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_panoptic_config_proto_msgTypes[1]
+	mi := &file_panoptic_config_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,7 +343,7 @@ func (x *TaskSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TaskSchedule.ProtoReflect.Descriptor instead.
 func (*TaskSchedule) Descriptor() ([]byte, []int) {
-	return file_panoptic_config_proto_rawDescGZIP(), []int{1}
+	return file_panoptic_config_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TaskSchedule) GetStartImmediatly() bool {
@@ -178,6 +367,34 @@ func (x *TaskSchedule) GetRoutinely() *Routinely {
 	return nil
 }
 
+func (x *TaskSchedule) GetCron() *Cron {
+	if x, ok := x.GetSchedule().(*TaskSchedule_Cron); ok {
+		return x.Cron
+	}
+	return nil
+}
+
+func (x *TaskSchedule) GetTimeWindows() *TimeWindows {
+	if x, ok := x.GetSchedule().(*TaskSchedule_TimeWindows); ok {
+		return x.TimeWindows
+	}
+	return nil
+}
+
+func (x *TaskSchedule) GetHolidayCalendar() *HolidayCalendar {
+	if x != nil {
+		return x.HolidayCalendar
+	}
+	return nil
+}
+
+func (x *TaskSchedule) GetJitterMilliseconds() int64 {
+	if x != nil {
+		return x.JitterMilliseconds
+	}
+	return 0
+}
+
 type isTaskSchedule_Schedule interface {
 	isTaskSchedule_Schedule()
 }
@@ -186,8 +403,20 @@ type TaskSchedule_Routinely struct {
 	Routinely *Routinely `protobuf:"bytes,2,opt,name=routinely,proto3,oneof"`
 }
 
+type TaskSchedule_Cron struct {
+	Cron *Cron `protobuf:"bytes,3,opt,name=cron,proto3,oneof"`
+}
+
+type TaskSchedule_TimeWindows struct {
+	TimeWindows *TimeWindows `protobuf:"bytes,4,opt,name=time_windows,json=timeWindows,proto3,oneof"`
+}
+
 func (*TaskSchedule_Routinely) isTaskSchedule_Schedule() {}
 
+func (*TaskSchedule_Cron) isTaskSchedule_Schedule() {}
+
+func (*TaskSchedule_TimeWindows) isTaskSchedule_Schedule() {}
+
 // Routinely defines a schedule that executes every other duration of time.
 type Routinely struct {
 	state         protoimpl.MessageState
@@ -200,7 +429,7 @@ type Routinely struct {
 func (x *Routinely) Reset() {
 	*x = Routinely{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_panoptic_config_proto_msgTypes[2]
+		mi := &file_panoptic_config_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -213,7 +442,7 @@ func (x *Routinely) String() string {
 func (*Routinely) ProtoMessage() {}
 
 func (x *Routinely) ProtoReflect() protoreflect.Message {
-	mi := &file_panoptic_config_proto_msgTypes[2]
+	mi := &file_panoptic_config_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +455,7 @@ func (x *Routinely) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Routinely.ProtoReflect.Descriptor instead.
 func (*Routinely) Descriptor() ([]byte, []int) {
-	return file_panoptic_config_proto_rawDescGZIP(), []int{2}
+	return file_panoptic_config_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Routinely) GetEveryMilliseconds() int64 {
@@ -236,6 +465,260 @@ func (x *Routinely) GetEveryMilliseconds() int64 {
 	return 0
 }
 
+// Cron defines a schedule that executes at times matching a cron expression.
+type Cron struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Standard 5-field cron expression (minute, hour, day of month, month, day
+	// of week), e.g. "*/5 9-15 * * MON-FRI". Descriptors like "@hourly" are also
+	// supported.
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// IANA time zone the expression is evaluated in, e.g. "Asia/Shanghai".
+	// Defaults to UTC.
+	TimeZone string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *Cron) Reset() {
+	*x = Cron{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cron) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cron) ProtoMessage() {}
+
+func (x *Cron) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cron.ProtoReflect.Descriptor instead.
+func (*Cron) Descriptor() ([]byte, []int) {
+	return file_panoptic_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Cron) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *Cron) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// TimeWindows defines a schedule whose interval depends on time of day, e.g.
+// every 30s during market hours on trading days, every 10m otherwise.
+type TimeWindows struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IANA time zone the windows are in, e.g. "Asia/Shanghai". Defaults to UTC.
+	TimeZone string `protobuf:"bytes,1,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// The first window covering current time decides the interval. When a
+	// window starts before the next run, the job runs at window start instead
+	// of waiting for the longer interval.
+	Windows []*TimeWindow `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	// Interval outside of all windows, and on holidays.
+	DefaultEveryMilliseconds int64 `protobuf:"varint,3,opt,name=default_every_milliseconds,json=defaultEveryMilliseconds,proto3" json:"default_every_milliseconds,omitempty"`
+}
+
+func (x *TimeWindows) Reset() {
+	*x = TimeWindows{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeWindows) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindows) ProtoMessage() {}
+
+func (x *TimeWindows) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindows.ProtoReflect.Descriptor instead.
+func (*TimeWindows) Descriptor() ([]byte, []int) {
+	return file_panoptic_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TimeWindows) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *TimeWindows) GetWindows() []*TimeWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *TimeWindows) GetDefaultEveryMilliseconds() int64 {
+	if x != nil {
+		return x.DefaultEveryMilliseconds
+	}
+	return 0
+}
+
+type TimeWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time of day in "HH:MM" 24-hour format. Start is inclusive, end is
+	// exclusive, and start must be before end.
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Days of week this window applies on, 0 is Sunday. Applies on every day if
+	// empty.
+	DaysOfWeek        []int32 `protobuf:"varint,3,rep,packed,name=days_of_week,json=daysOfWeek,proto3" json:"days_of_week,omitempty"`
+	EveryMilliseconds int64   `protobuf:"varint,4,opt,name=every_milliseconds,json=everyMilliseconds,proto3" json:"every_milliseconds,omitempty"`
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_config_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_config_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_panoptic_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TimeWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *TimeWindow) GetDaysOfWeek() []int32 {
+	if x != nil {
+		return x.DaysOfWeek
+	}
+	return nil
+}
+
+func (x *TimeWindow) GetEveryMilliseconds() int64 {
+	if x != nil {
+		return x.EveryMilliseconds
+	}
+	return 0
+}
+
+type HolidayCalendar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Dates in "YYYY-MM-DD" format.
+	Dates []string `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
+}
+
+func (x *HolidayCalendar) Reset() {
+	*x = HolidayCalendar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_panoptic_config_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HolidayCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HolidayCalendar) ProtoMessage() {}
+
+func (x *HolidayCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_panoptic_config_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HolidayCalendar.ProtoReflect.Descriptor instead.
+func (*HolidayCalendar) Descriptor() ([]byte, []int) {
+	return file_panoptic_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *HolidayCalendar) GetDates() []string {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
 // This message is used for the purpose of config push for the scheduler.
 type PanopticConfigs struct {
 	state         protoimpl.MessageState
@@ -249,7 +732,7 @@ type PanopticConfigs struct {
 func (x *PanopticConfigs) Reset() {
 	*x = PanopticConfigs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_panoptic_config_proto_msgTypes[3]
+		mi := &file_panoptic_config_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -262,7 +745,7 @@ func (x *PanopticConfigs) String() string {
 func (*PanopticConfigs) ProtoMessage() {}
 
 func (x *PanopticConfigs) ProtoReflect() protoreflect.Message {
-	mi := &file_panoptic_config_proto_msgTypes[3]
+	mi := &file_panoptic_config_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -275,7 +758,7 @@ func (x *PanopticConfigs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PanopticConfigs.ProtoReflect.Descriptor instead.
 func (*PanopticConfigs) Descriptor() ([]byte, []int) {
-	return file_panoptic_config_proto_rawDescGZIP(), []int{3}
+	return file_panoptic_config_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PanopticConfigs) GetConfig() []*PanopticConfig {
@@ -291,7 +774,7 @@ var file_panoptic_config_proto_rawDesc = []byte{
 	0x0a, 0x15, 0x70, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
 	0x6c, 0x1a, 0x0e, 0x70, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x85, 0x02, 0x0a, 0x0e, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x43, 0x6f,
+	0x6f, 0x22, 0xa3, 0x03, 0x0a, 0x0e, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61,
 	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
@@ -307,27 +790,99 @@ var file_panoptic_config_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
 	0x6c, 0x65, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
 	0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x7a, 0x0a, 0x0c, 0x54, 0x61, 0x73,
-	0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x6c, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x74, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x6c,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x09,
-	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x6c, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x3a, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65,
-	0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c,
-	0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
-	0x65, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x22, 0x43, 0x0a, 0x0f, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
-	0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4c, 0x75, 0x69, 0x73, 0x6d, 0x6f, 0x72, 0x6c, 0x61, 0x6e, 0x2f,
-	0x6e, 0x65, 0x77, 0x73, 0x6d, 0x75, 0x78, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65,
-	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x3e, 0x0a, 0x0e, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x12, 0x44, 0x0a, 0x10, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f,
+	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x41, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50,
+	0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x61, 0x64, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65,
+	0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0xb8, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x61, 0x70,
+	0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x6d,
+	0x69, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6d, 0x69, 0x6e,
+	0x45, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6d,
+	0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x45, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x61,
+	0x79, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x38, 0x0a,
+	0x18, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x16, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x47, 0x0a, 0x20, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x1d, 0x70, 0x61, 0x75, 0x73, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0xd3, 0x02, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x74, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x6c, 0x79, 0x12, 0x33, 0x0a, 0x09,
+	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x65, 0x6c, 0x79, 0x48, 0x00, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x6c,
+	0x79, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x5f, 0x63,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x0f, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x6a, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x3a, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x65, 0x6c, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x6c,
+	0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x65, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0x43, 0x0a, 0x04, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x65, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x45, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x61, 0x79,
+	0x73, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x0a, 0x64, 0x61, 0x79, 0x73, 0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x2d, 0x0a, 0x12, 0x65,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x76, 0x65, 0x72, 0x79, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x27, 0x0a, 0x0f, 0x48, 0x6f,
+	0x6c, 0x69, 0x64, 0x61, 0x79, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x43, 0x0a, 0x0f, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x50, 0x61, 0x6e, 0x6f, 0x70, 0x74, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4c, 0x75, 0x69, 0x73, 0x6d, 0x6f, 0x72, 0x6c, 0x61,
+	0x6e, 0x2f, 0x6e, 0x65, 0x77, 0x73, 0x6d, 0x75, 0x78, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -342,26 +897,38 @@ func file_panoptic_config_proto_rawDescGZIP() []byte {
 	return file_panoptic_config_proto_rawDescData
 }
 
-var file_panoptic_config_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_panoptic_config_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_panoptic_config_proto_goTypes = []interface{}{
 	(*PanopticConfig)(nil),            // 0: protocol.PanopticConfig
-	(*TaskSchedule)(nil),              // 1: protocol.TaskSchedule
-	(*Routinely)(nil),                 // 2: protocol.Routinely
-	(*PanopticConfigs)(nil),           // 3: protocol.PanopticConfigs
-	(PanopticTask_DataCollectorId)(0), // 4: protocol.PanopticTask.DataCollectorId
-	(*TaskParams)(nil),                // 5: protocol.TaskParams
+	(*AdaptivePolling)(nil),           // 1: protocol.AdaptivePolling
+	(*FailurePolicy)(nil),             // 2: protocol.FailurePolicy
+	(*TaskSchedule)(nil),              // 3: protocol.TaskSchedule
+	(*Routinely)(nil),                 // 4: protocol.Routinely
+	(*Cron)(nil),                      // 5: protocol.Cron
+	(*TimeWindows)(nil),               // 6: protocol.TimeWindows
+	(*TimeWindow)(nil),                // 7: protocol.TimeWindow
+	(*HolidayCalendar)(nil),           // 8: protocol.HolidayCalendar
+	(*PanopticConfigs)(nil),           // 9: protocol.PanopticConfigs
+	(PanopticTask_DataCollectorId)(0), // 10: protocol.PanopticTask.DataCollectorId
+	(*TaskParams)(nil),                // 11: protocol.TaskParams
 }
 var file_panoptic_config_proto_depIdxs = []int32{
-	4, // 0: protocol.PanopticConfig.data_collector_id:type_name -> protocol.PanopticTask.DataCollectorId
-	5, // 1: protocol.PanopticConfig.task_params:type_name -> protocol.TaskParams
-	1, // 2: protocol.PanopticConfig.task_schedule:type_name -> protocol.TaskSchedule
-	2, // 3: protocol.TaskSchedule.routinely:type_name -> protocol.Routinely
-	0, // 4: protocol.PanopticConfigs.config:type_name -> protocol.PanopticConfig
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	10, // 0: protocol.PanopticConfig.data_collector_id:type_name -> protocol.PanopticTask.DataCollectorId
+	11, // 1: protocol.PanopticConfig.task_params:type_name -> protocol.TaskParams
+	3,  // 2: protocol.PanopticConfig.task_schedule:type_name -> protocol.TaskSchedule
+	2,  // 3: protocol.PanopticConfig.failure_policy:type_name -> protocol.FailurePolicy
+	1,  // 4: protocol.PanopticConfig.adaptive_polling:type_name -> protocol.AdaptivePolling
+	4,  // 5: protocol.TaskSchedule.routinely:type_name -> protocol.Routinely
+	5,  // 6: protocol.TaskSchedule.cron:type_name -> protocol.Cron
+	6,  // 7: protocol.TaskSchedule.time_windows:type_name -> protocol.TimeWindows
+	8,  // 8: protocol.TaskSchedule.holiday_calendar:type_name -> protocol.HolidayCalendar
+	7,  // 9: protocol.TimeWindows.windows:type_name -> protocol.TimeWindow
+	0,  // 10: protocol.PanopticConfigs.config:type_name -> protocol.PanopticConfig
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_panoptic_config_proto_init() }
@@ -384,7 +951,7 @@ func file_panoptic_config_proto_init() {
 			}
 		}
 		file_panoptic_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TaskSchedule); i {
+			switch v := v.(*AdaptivePolling); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -396,7 +963,7 @@ func file_panoptic_config_proto_init() {
 			}
 		}
 		file_panoptic_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Routinely); i {
+			switch v := v.(*FailurePolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -408,6 +975,78 @@ func file_panoptic_config_proto_init() {
 			}
 		}
 		file_panoptic_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Routinely); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cron); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeWindows); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HolidayCalendar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_panoptic_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PanopticConfigs); i {
 			case 0:
 				return &v.state
@@ -420,8 +1059,10 @@ func file_panoptic_config_proto_init() {
 			}
 		}
 	}
-	file_panoptic_config_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_panoptic_config_proto_msgTypes[3].OneofWrappers = []interface{}{
 		(*TaskSchedule_Routinely)(nil),
+		(*TaskSchedule_Cron)(nil),
+		(*TaskSchedule_TimeWindows)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -429,7 +1070,7 @@ func file_panoptic_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_panoptic_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
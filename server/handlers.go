@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/Luismorlan/newsmux/server/graph/generated"
 	"github.com/Luismorlan/newsmux/server/resolver"
@@ -39,7 +40,7 @@ func GraphqlHandler() gin.HandlerFunc {
 		DB:               db,
 		RedisStatusStore: redis,
 		SignalChans:      resolver.NewSignalChannels(),
-	}}))
+	}, Complexity: resolver.NewComplexityRoot()}))
 
 	h.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second,
@@ -55,6 +56,12 @@ func GraphqlHandler() gin.HandlerFunc {
 	h.AddTransport(transport.GET{})
 	h.AddTransport(transport.POST{})
 
+	// Reject expensive queries before resolving any field.
+	h.Use(extension.FixedComplexityLimit(resolver.MaxQueryComplexity))
+
+	// Personal access tokens are restricted to their scopes.
+	h.AroundFields(resolver.ScopeFieldMiddleware)
+
 	return func(c *gin.Context) {
 		h.ServeHTTP(c.Writer, c.Request)
 	}
@@ -0,0 +1,60 @@
+package middlewares
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Identity is the authenticated caller of a request, carried in request
+// context so that resolvers don't need to trust user ids in their inputs.
+type Identity struct {
+	// Subject is the authenticated user id.
+	Subject string
+	// BypassAuth is only set by the BypassAuth middleware for local
+	// development, such identity is allowed to act as any user.
+	BypassAuth bool
+	// Scopes restricts what the identity is allowed to do, nil means full
+	// access as a browser login has.
+	Scopes []string
+}
+
+// HasScope returns whether the identity is granted scope, feeds:write implies
+// read.
+func (i *Identity) HasScope(scope string) bool {
+	if i.Scopes == nil {
+		return true
+	}
+	for _, s := range i.Scopes {
+		if s == scope || (s == ScopeFeedsWrite && scope == ScopeRead) {
+			return true
+		}
+	}
+	return false
+}
+
+type identityContextKey struct{}
+
+// WithIdentity returns a copy of ctx carrying the identity.
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+// IdentityFromContext returns the identity carried in ctx, or false if the
+// request is not authenticated.
+func IdentityFromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityContextKey{}).(*Identity)
+	return identity, ok && identity != nil
+}
+
+// BypassAuth middleware injects a fake identity which can act as any user. It
+// must only be used with flag -no_auth for local development.
+func BypassAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(WithIdentity(c.Request.Context(), &Identity{
+			Subject:    c.GetHeader("sub"),
+			BypassAuth: true,
+		}))
+		c.Next()
+	}
+}
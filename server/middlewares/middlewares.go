@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"net/http"
+	"strings"
 
 	"github.com/Luismorlan/newsmux/utils"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -47,14 +48,57 @@ func setCognitoClient(client *cognitoidentityprovider.Client) {
 	cognitoClient = client
 }
 
-// JWT middleware fetch user jwt in the http header, looking for field "token".
-// It then parse the JWT and add a new field "sub" stores user's id. It returns
-// error on token not provided or token is invalid (wrong token or expired).
+// defaultVerifier chains all supported verifiers, personal access tokens are
+// checked first since they are recognized by prefix, local JWT is only enabled
+// if its keys are configured in env.
+func defaultVerifier() (Verifier, error) {
+	db, err := utils.GetDBConnection()
+	if err != nil {
+		return nil, err
+	}
+	chain := ChainVerifier{&PersonalAccessTokenVerifier{DB: db}}
+	local, err := NewLocalJWTVerifierFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	if local != nil {
+		chain = append(chain, local)
+	}
+	return append(chain, &CognitoVerifier{Client: cognitoClient}), nil
+}
+
+// bearerToken returns the token in header "Authorization: Bearer <token>",
+// falling back to query parameter "token" used by browser clients.
+func bearerToken(c *gin.Context) string {
+	auth := c.GetHeader("Authorization")
+	if len(auth) > len(bearerPrefix) && strings.EqualFold(auth[:len(bearerPrefix)], bearerPrefix) {
+		return strings.TrimSpace(auth[len(bearerPrefix):])
+	}
+	return c.Query("token")
+}
+
+const bearerPrefix = "Bearer "
+
+// JWT middleware fetch user token in the http header "Authorization", or
+// query parameter "token". It then verifies the token, which can be a Cognito
+// JWT, a personal access token or a locally signed JWT, and add a new field
+// "sub" stores user's id, the same id is also carried in request context as
+// Identity. It returns error on token not provided or token is invalid (wrong
+// token or expired). Must be called after env is loaded.
 func JWT() gin.HandlerFunc {
+	verifier, err := defaultVerifier()
+	if err != nil {
+		log.Fatalf("fail to setup token verifier: %s", err.Error())
+	}
+	return JWTWithVerifier(verifier)
+}
+
+// JWTWithVerifier is JWT middleware with a customized verifier.
+func JWTWithVerifier(verifier Verifier) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		jwt := c.Query("token")
+		token := bearerToken(c)
 
-		if jwt == "" {
+		if token == "" {
 			c.JSON(http.StatusUnauthorized, gin.H{
 				"code": utils.ErrorTokenAuthFail,
 				"msg":  "empty jwt token",
@@ -63,7 +107,7 @@ func JWT() gin.HandlerFunc {
 			return
 		}
 
-		user, err := cognitoClient.GetUser(context.TODO(), &cognitoidentityprovider.GetUserInput{AccessToken: &jwt})
+		identity, err := verifier.Verify(c.Request.Context(), token)
 
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, gin.H{
@@ -74,10 +118,12 @@ func JWT() gin.HandlerFunc {
 			return
 		}
 
-		// Successfully validated the jwt token, replace the header field "token"
-		// with the user's sub (id).
+		// Successfully validated the token, replace the header field "token"
+		// with the user's sub (id), and carry the identity in request context for
+		// resolvers to authorize the caller.
 		c.Request.Header.Del("token")
-		c.Request.Header.Add("sub", *user.Username)
+		c.Request.Header.Add("sub", identity.Subject)
+		c.Request = c.Request.WithContext(WithIdentity(c.Request.Context(), identity))
 
 		// before request
 		c.Next()
@@ -0,0 +1,84 @@
+package middlewares
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Luismorlan/newsmux/utils"
+	. "github.com/Luismorlan/newsmux/utils/log"
+)
+
+var (
+	// DefaultIPRateLimit applies to all requests before authentication, it's
+	// looser than user limit since many users can share an ip behind NAT.
+	DefaultIPRateLimit = utils.RateLimit{Rate: 50, Burst: 200}
+	// DefaultUserRateLimit applies to authenticated requests of each user.
+	DefaultUserRateLimit = utils.RateLimit{Rate: 10, Burst: 60}
+)
+
+// RateLimiter takes a token from a bucket identified by bucket and id.
+type RateLimiter interface {
+	AllowRequest(bucket string, id string, limit utils.RateLimit) (bool, time.Duration, error)
+}
+
+// RateLimitByIP middleware rejects requests with 429 once an ip runs out of
+// tokens. It should be used before JWT so that unauthenticated floods are
+// limited as well. The ip is the peer address, X-Forwarded-For is ignored
+// since clients can set it to anything and get a new bucket every request.
+func RateLimitByIP(limiter RateLimiter, limit utils.RateLimit) gin.HandlerFunc {
+	return rateLimit(limiter, "ip", limit, func(c *gin.Context) string {
+		ip, _ := c.RemoteIP()
+		if ip == nil {
+			return ""
+		}
+		return ip.String()
+	})
+}
+
+// RateLimitByUser middleware rejects requests with 429 once an authenticated
+// user runs out of tokens. It must be used after JWT, requests without
+// identity are not limited by it.
+func RateLimitByUser(limiter RateLimiter, limit utils.RateLimit) gin.HandlerFunc {
+	return rateLimit(limiter, "user", limit, func(c *gin.Context) string {
+		identity, ok := IdentityFromContext(c.Request.Context())
+		if !ok || identity.Subject == "" {
+			return ""
+		}
+		return identity.Subject
+	})
+}
+
+func rateLimit(limiter RateLimiter, bucket string, limit utils.RateLimit, keyFunc func(c *gin.Context) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := keyFunc(c)
+		if id == "" {
+			c.Next()
+			return
+		}
+
+		allowed, retryAfter, err := limiter.AllowRequest(bucket, id, limit)
+		if err != nil {
+			// Fail open, Redis outage shouldn't take down the API.
+			Log.Errorf("fail to check rate limit of %s %s: %v", bucket, id, err)
+			c.Next()
+			return
+		}
+		if !allowed {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			c.JSON(http.StatusTooManyRequests, gin.H{
+				"code":              utils.ErrorRateLimited,
+				"msg":               "too many requests",
+				"retryAfterSeconds": seconds,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,94 @@
+package middlewares
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Luismorlan/newsmux/utils"
+)
+
+type fakeRateLimiter struct {
+	allowed bool
+	err     error
+	ids     []string
+}
+
+func (l *fakeRateLimiter) AllowRequest(bucket string, id string, limit utils.RateLimit) (bool, time.Duration, error) {
+	l.ids = append(l.ids, bucket+":"+id)
+	return l.allowed, 1500 * time.Millisecond, l.err
+}
+
+func serveWithRateLimit(middlewares ...gin.HandlerFunc) *httptest.ResponseRecorder {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(middlewares...)
+	router.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimit(t *testing.T) {
+	limiter := &fakeRateLimiter{allowed: false}
+	w := serveWithRateLimit(RateLimitByIP(limiter, DefaultIPRateLimit))
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	assert.Contains(t, w.Body.String(), `"retryAfterSeconds":2`)
+
+	// Fail open on limiter error.
+	limiter = &fakeRateLimiter{err: errors.New("redis down")}
+	w = serveWithRateLimit(RateLimitByIP(limiter, DefaultIPRateLimit))
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// User limit is keyed by identity, and skipped without identity.
+	limiter = &fakeRateLimiter{allowed: true}
+	w = serveWithRateLimit(RateLimitByUser(limiter, DefaultUserRateLimit))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 0, len(limiter.ids))
+
+	injectIdentity := func(c *gin.Context) {
+		c.Request = c.Request.WithContext(WithIdentity(c.Request.Context(), &Identity{Subject: "user_id"}))
+	}
+	w = serveWithRateLimit(injectIdentity, RateLimitByUser(limiter, DefaultUserRateLimit))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"user:user_id"}, limiter.ids)
+}
+
+func TestRateLimitByIPIgnoresForwardedFor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limiter := &fakeRateLimiter{allowed: true}
+	router := gin.New()
+	router.Use(RateLimitByIP(limiter, DefaultIPRateLimit))
+	router.GET("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	// Served by Run like in production, which makes gin trust all proxies by
+	// default.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+	go router.Run(addr)
+
+	for _, forwardedFor := range []string{"1.2.3.4", "5.6.7.8"} {
+		req, _ := http.NewRequest("GET", "http://"+addr+"/", nil)
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+		var resp *http.Response
+		require.Eventually(t, func() bool {
+			resp, err = http.DefaultClient.Do(req)
+			return err == nil
+		}, 5*time.Second, 10*time.Millisecond)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	assert.Equal(t, []string{"ip:127.0.0.1", "ip:127.0.0.1"}, limiter.ids)
+}
@@ -0,0 +1,108 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeVerifier struct {
+	identity *Identity
+	err      error
+}
+
+func (v *fakeVerifier) Verify(ctx context.Context, token string) (*Identity, error) {
+	return v.identity, v.err
+}
+
+func TestChainVerifier(t *testing.T) {
+	ctx := context.Background()
+	notRecognized := &fakeVerifier{err: ErrTokenNotRecognized}
+	rejected := &fakeVerifier{err: errors.New("rejected")}
+	accepted := &fakeVerifier{identity: &Identity{Subject: "user_id"}}
+
+	identity, err := ChainVerifier{notRecognized, accepted}.Verify(ctx, "token")
+	assert.Nil(t, err)
+	assert.Equal(t, "user_id", identity.Subject)
+
+	_, err = ChainVerifier{notRecognized, rejected, accepted}.Verify(ctx, "token")
+	assert.Equal(t, "rejected", err.Error())
+
+	_, err = ChainVerifier{notRecognized}.Verify(ctx, "token")
+	assert.True(t, errors.Is(err, ErrTokenNotRecognized))
+}
+
+func TestLocalJWTVerifierHmac(t *testing.T) {
+	ctx := context.Background()
+	v := &LocalJWTVerifier{HmacSecret: []byte("secret")}
+
+	token, err := SignLocalJWT([]byte("secret"), "user_id", []string{ScopeRead}, time.Minute)
+	assert.Nil(t, err)
+	identity, err := v.Verify(ctx, token)
+	assert.Nil(t, err)
+	assert.Equal(t, "user_id", identity.Subject)
+	assert.Equal(t, []string{ScopeRead}, identity.Scopes)
+
+	token, _ = SignLocalJWT([]byte("secret"), "user_id", nil, time.Minute)
+	identity, err = v.Verify(ctx, token)
+	assert.Nil(t, err)
+	assert.Nil(t, identity.Scopes)
+
+	token, _ = SignLocalJWT([]byte("wrong"), "user_id", nil, time.Minute)
+	_, err = v.Verify(ctx, token)
+	assert.NotNil(t, err)
+	assert.False(t, errors.Is(err, ErrTokenNotRecognized))
+
+	token, _ = SignLocalJWT([]byte("secret"), "user_id", nil, -time.Minute)
+	_, err = v.Verify(ctx, token)
+	assert.NotNil(t, err)
+
+	// Not a local JWT, e.g. a Cognito token, should fall through.
+	_, err = v.Verify(ctx, "not a jwt")
+	assert.True(t, errors.Is(err, ErrTokenNotRecognized))
+}
+
+func TestLocalJWTVerifierRsa(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	v := &LocalJWTVerifier{RsaPublicKey: &key.PublicKey}
+
+	token, err := SignLocalJWT(key, "user_id", nil, time.Minute)
+	assert.Nil(t, err)
+	identity, err := v.Verify(context.Background(), token)
+	assert.Nil(t, err)
+	assert.Equal(t, "user_id", identity.Subject)
+
+	// HMAC is not accepted if only RSA key is configured.
+	token, _ = SignLocalJWT([]byte("secret"), "user_id", nil, time.Minute)
+	_, err = v.Verify(context.Background(), token)
+	assert.NotNil(t, err)
+}
+
+func TestNewPersonalAccessToken(t *testing.T) {
+	token, hash, err := NewPersonalAccessToken()
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(token, PersonalAccessTokenPrefix))
+	assert.Equal(t, hash, HashPersonalAccessToken(token))
+	assert.NotContains(t, hash, token)
+
+	other, _, _ := NewPersonalAccessToken()
+	assert.NotEqual(t, token, other)
+
+	// Tokens without the prefix are left for other verifiers.
+	_, err = (&PersonalAccessTokenVerifier{}).Verify(context.Background(), "jwt")
+	assert.True(t, errors.Is(err, ErrTokenNotRecognized))
+}
+
+func TestIdentityHasScope(t *testing.T) {
+	assert.True(t, (&Identity{}).HasScope(ScopeFeedsWrite))
+	assert.True(t, (&Identity{Scopes: []string{ScopeFeedsWrite}}).HasScope(ScopeRead))
+	assert.False(t, (&Identity{Scopes: []string{ScopeRead}}).HasScope(ScopeFeedsWrite))
+	assert.False(t, (&Identity{Scopes: []string{}}).HasScope(ScopeRead))
+}
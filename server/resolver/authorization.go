@@ -0,0 +1,36 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Luismorlan/newsmux/server/middlewares"
+)
+
+var (
+	errUnauthenticated = errors.New("unauthenticated request")
+	errUnauthorized    = errors.New("not allowed to act on behalf of another user")
+)
+
+// authenticate returns the caller's identity injected by auth middlewares.
+func authenticate(ctx context.Context) (*middlewares.Identity, error) {
+	identity, ok := middlewares.IdentityFromContext(ctx)
+	if !ok {
+		return nil, errUnauthenticated
+	}
+	return identity, nil
+}
+
+// authorizeUser rejects the request if the caller is not the user the request
+// claims to act on behalf of. Every resolver taking a user id from its input
+// must call this before touching any data.
+func authorizeUser(ctx context.Context, userId string) error {
+	identity, err := authenticate(ctx)
+	if err != nil {
+		return err
+	}
+	if identity.BypassAuth || identity.Subject == userId {
+		return nil
+	}
+	return errUnauthorized
+}
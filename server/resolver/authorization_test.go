@@ -0,0 +1,242 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/server/middlewares"
+	"github.com/Luismorlan/newsmux/utils"
+)
+
+func TestAuthorizeUser(t *testing.T) {
+	assert.Equal(t, errUnauthenticated, authorizeUser(context.Background(), "user_id"))
+
+	ctx := middlewares.WithIdentity(context.Background(), &middlewares.Identity{Subject: "user_id"})
+	assert.Nil(t, authorizeUser(ctx, "user_id"))
+	assert.Equal(t, errUnauthorized, authorizeUser(ctx, "other_user_id"))
+
+	ctx = middlewares.WithIdentity(context.Background(), &middlewares.Identity{BypassAuth: true})
+	assert.Nil(t, authorizeUser(ctx, "other_user_id"))
+}
+
+// Every resolver acting on behalf of a user must reject a caller
+// authenticated as another user, before touching any data. Resolvers here
+// don't have DB, so any resolver missing the check would panic.
+func TestResolversRejectMismatchedUser(t *testing.T) {
+	r := &Resolver{}
+	m := &mutationResolver{r}
+	q := &queryResolver{r}
+	s := &subscriptionResolver{r}
+	ctx := middlewares.WithIdentity(context.Background(), &middlewares.Identity{Subject: "caller_id"})
+	other := "other_user_id"
+	feedId := "feed_id"
+
+	calls := map[string]func() error{
+		"createUser": func() error {
+			_, err := m.CreateUser(ctx, model.NewUserInput{ID: other})
+			return err
+		},
+		"upsertFeed": func() error {
+			_, err := m.UpsertFeed(ctx, model.UpsertFeedInput{UserID: other, FeedID: &feedId})
+			return err
+		},
+		"deleteFeed": func() error {
+			_, err := m.DeleteFeed(ctx, model.DeleteFeedInput{UserID: other, FeedID: feedId})
+			return err
+		},
+		"subscribe": func() error {
+			_, err := m.Subscribe(ctx, model.SubscribeInput{UserID: other, FeedID: feedId})
+			return err
+		},
+		"createSource": func() error {
+			_, err := m.CreateSource(ctx, model.NewSourceInput{UserID: other})
+			return err
+		},
+		"syncUp": func() error {
+			_, err := m.SyncUp(ctx, &model.SeedStateInput{UserSeedState: &model.UserSeedStateInput{ID: other}})
+			return err
+		},
+		"setItemsReadStatus": func() error {
+			_, err := m.SetItemsReadStatus(ctx, model.SetItemsReadStatusInput{UserID: other})
+			return err
+		},
+		"rotateFeedSyndicationToken": func() error {
+			_, err := m.RotateFeedSyndicationToken(ctx, model.RotateFeedSyndicationTokenInput{UserID: other, FeedID: feedId})
+			return err
+		},
+		"importFeeds": func() error {
+			_, err := m.ImportFeeds(ctx, model.ImportFeedsInput{UserID: other})
+			return err
+		},
+		"cloneFeed": func() error {
+			_, err := m.CloneFeed(ctx, model.CloneFeedInput{UserID: other, FeedID: feedId})
+			return err
+		},
+		"addFeedCollaborator": func() error {
+			_, err := m.AddFeedCollaborator(ctx, model.AddFeedCollaboratorInput{UserID: other, FeedID: feedId})
+			return err
+		},
+		"removeFeedCollaborator": func() error {
+			_, err := m.RemoveFeedCollaborator(ctx, model.RemoveFeedCollaboratorInput{UserID: other, FeedID: feedId})
+			return err
+		},
+		"markFeedRead": func() error {
+			_, err := m.MarkFeedRead(ctx, model.MarkFeedReadInput{UserID: other, FeedID: feedId})
+			return err
+		},
+		"mute": func() error {
+			_, err := m.Mute(ctx, model.MuteInput{UserID: other, Type: model.MuteTypeKeyword, Target: "keyword"})
+			return err
+		},
+		"unmute": func() error {
+			_, err := m.Unmute(ctx, model.UnmuteInput{UserID: other, MuteID: "mute_id"})
+			return err
+		},
+		"restoreFeedVersion": func() error {
+			_, err := m.RestoreFeedVersion(ctx, model.RestoreFeedVersionInput{UserID: other, FeedID: feedId, Version: 1})
+			return err
+		},
+		"createPersonalAccessToken": func() error {
+			_, err := m.CreatePersonalAccessToken(ctx, model.CreatePersonalAccessTokenInput{UserID: other})
+			return err
+		},
+		"revokePersonalAccessToken": func() error {
+			_, err := m.RevokePersonalAccessToken(ctx, model.RevokePersonalAccessTokenInput{UserID: other, TokenID: "token_id"})
+			return err
+		},
+		"userState": func() error {
+			_, err := q.UserState(ctx, model.UserStateInput{UserID: other})
+			return err
+		},
+		"feeds": func() error {
+			_, err := q.Feeds(ctx, &model.FeedsGetPostsInput{UserID: other})
+			return err
+		},
+		"exportFeeds": func() error {
+			_, err := q.ExportFeeds(ctx, model.ExportFeedsInput{UserID: other})
+			return err
+		},
+		"feedStats": func() error {
+			_, err := q.FeedStats(ctx, model.FeedStatsInput{UserID: other, FeedID: feedId})
+			return err
+		},
+		"feedHistory": func() error {
+			_, err := q.FeedHistory(ctx, model.FeedHistoryInput{UserID: other, FeedID: feedId})
+			return err
+		},
+		"personalAccessTokens": func() error {
+			_, err := q.PersonalAccessTokens(ctx, model.PersonalAccessTokensInput{UserID: other})
+			return err
+		},
+		"signal": func() error {
+			_, err := s.Signal(ctx, other)
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, errUnauthorized, call())
+		})
+	}
+}
+
+func TestResolversRejectUnauthenticated(t *testing.T) {
+	m := &mutationResolver{&Resolver{}}
+	q := &queryResolver{&Resolver{}}
+	ctx := context.Background()
+
+	calls := map[string]func() error{
+		"createPost": func() error {
+			_, err := m.CreatePost(ctx, model.NewPostInput{})
+			return err
+		},
+		"upsertSubSource": func() error {
+			_, err := m.UpsertSubSource(ctx, model.UpsertSubSourceInput{})
+			return err
+		},
+		"addWeiboSubSource": func() error {
+			_, err := m.AddWeiboSubSource(ctx, model.AddWeiboSubSourceInput{})
+			return err
+		},
+		"addSubSource": func() error {
+			_, err := m.AddSubSource(ctx, model.AddSubSourceInput{})
+			return err
+		},
+		"deleteSubSource": func() error {
+			_, err := m.DeleteSubSource(ctx, &model.DeleteSubSourceInput{})
+			return err
+		},
+		"tryCustomizedCrawler": func() error {
+			_, err := q.TryCustomizedCrawler(ctx, &model.CustomizedCrawlerParams{})
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, errUnauthenticated, call())
+		})
+	}
+}
+
+// syncUp only renames feeds the caller can edit, other feeds in the seed state
+// are left as is.
+func TestSyncUpRejectsRenameByNonCollaborator(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	redis, _ := utils.GetRedisStatusStore()
+
+	client := PrepareTestForGraphQLAPIs(db, redis)
+
+	ownerId := utils.TestCreateUserAndValidate(t, "test_user_name", "owner_user_id", db, client)
+	callerId := utils.TestCreateUserAndValidate(t, "test_user_name", "caller_user_id", db, client)
+	feedId, _ := utils.TestCreateFeedAndValidate(t, ownerId, "owner_feed", `{"a":1}`, []string{}, model.VisibilityPrivate, db, client)
+	callerFeedId, _ := utils.TestCreateFeedAndValidate(t, callerId, "caller_feed", `{"a":1}`, []string{}, model.VisibilityPrivate, db, client)
+
+	m := &mutationResolver{&Resolver{DB: db, RedisStatusStore: redis, SignalChans: NewSignalChannels()}}
+	ctx := middlewares.WithIdentity(context.Background(), &middlewares.Identity{Subject: callerId})
+	_, err := m.SyncUp(ctx, &model.SeedStateInput{
+		UserSeedState: &model.UserSeedStateInput{ID: callerId, Name: "test_user_name"},
+		FeedSeedState: []*model.FeedSeedStateInput{
+			{ID: feedId, Name: "renamed_by_caller"},
+			{ID: callerFeedId, Name: "caller_feed_renamed"},
+		},
+	})
+	require.Nil(t, err)
+
+	var feed model.Feed
+	db.First(&feed, "id = ?", feedId)
+	require.Equal(t, "owner_feed", feed.Name)
+	db.First(&feed, "id = ?", callerFeedId)
+	require.Equal(t, "caller_feed_renamed", feed.Name)
+}
+
+// markFeedRead doesn't touch or leak PRIVATE feeds the caller can't read.
+func TestMarkFeedReadRejectsNonSubscriber(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	redis, _ := utils.GetRedisStatusStore()
+
+	client := PrepareTestForGraphQLAPIs(db, redis)
+
+	ownerId := utils.TestCreateUserAndValidate(t, "test_user_name", "owner_user_id", db, client)
+	callerId := utils.TestCreateUserAndValidate(t, "test_user_name", "caller_user_id", db, client)
+	feedId, _ := utils.TestCreateFeedAndValidate(t, ownerId, "owner_feed", `{"a":1}`, []string{}, model.VisibilityPrivate, db, client)
+
+	m := &mutationResolver{&Resolver{DB: db, RedisStatusStore: redis, SignalChans: NewSignalChannels()}}
+	ctx := middlewares.WithIdentity(context.Background(), &middlewares.Identity{Subject: callerId})
+	_, err := m.MarkFeedRead(ctx, model.MarkFeedReadInput{UserID: callerId, FeedID: feedId, UpToCursor: 100})
+	require.NotNil(t, err)
+
+	watermarks, err := redis.GetFeedReadWatermarks(callerId)
+	require.Nil(t, err)
+	_, ok := watermarks[feedId]
+	require.False(t, ok)
+
+	ctx = middlewares.WithIdentity(context.Background(), &middlewares.Identity{Subject: ownerId})
+	_, err = m.MarkFeedRead(ctx, model.MarkFeedReadInput{UserID: ownerId, FeedID: feedId, UpToCursor: 100})
+	require.Nil(t, err)
+}
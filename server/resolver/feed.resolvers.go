@@ -23,7 +23,37 @@ func (r *feedResolver) SubscriberCount(ctx context.Context, obj *model.Feed) (*i
 	return &res, nil
 }
 
+func (r *feedResolver) CloneCount(ctx context.Context, obj *model.Feed) (*int, error) {
+	var count int64
+	r.DB.Model(&model.Feed{}).
+		Where("cloned_from_feed_id = ?", obj.Id).
+		Count(&count)
+	res := int(count)
+	return &res, nil
+}
+
+func (r *feedResolver) Collaborators(ctx context.Context, obj *model.Feed) ([]*model.FeedCollaborator, error) {
+	var collaborators []*model.FeedCollaborator
+	err := r.DB.Preload("User").
+		Where("feed_id = ?", obj.Id).
+		Order("created_at").
+		Find(&collaborators).Error
+	return collaborators, err
+}
+
+func (r *feedVersionResolver) FilterDataExpression(ctx context.Context, obj *model.FeedVersion) (string, error) {
+	return string(obj.FilterDataExpression), nil
+}
+
+func (r *feedVersionResolver) SubSourceIds(ctx context.Context, obj *model.FeedVersion) ([]string, error) {
+	return obj.SubSourceIds, nil
+}
+
 // Feed returns generated.FeedResolver implementation.
 func (r *Resolver) Feed() generated.FeedResolver { return &feedResolver{r} }
 
+// FeedVersion returns generated.FeedVersionResolver implementation.
+func (r *Resolver) FeedVersion() generated.FeedVersionResolver { return &feedVersionResolver{r} }
+
 type feedResolver struct{ *Resolver }
+type feedVersionResolver struct{ *Resolver }
@@ -0,0 +1,61 @@
+package resolver
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/model"
+)
+
+// Roles are ordered, a role includes all permissions of lower roles.
+var feedCollaboratorRoleRank = map[model.FeedCollaboratorRole]int{
+	model.FeedCollaboratorRoleViewer: 1,
+	model.FeedCollaboratorRoleEditor: 2,
+	model.FeedCollaboratorRoleOwner:  3,
+}
+
+// getFeedRole returns the user's role on the feed, feed creator is always the
+// owner. Returns false if the user has no role on the feed.
+func getFeedRole(db *gorm.DB, feed *model.Feed, userId string) (model.FeedCollaboratorRole, bool) {
+	if feed.CreatorID == userId {
+		return model.FeedCollaboratorRoleOwner, true
+	}
+	var collaborator model.FeedCollaborator
+	if db.Where("feed_id = ? AND user_id = ?", feed.Id, userId).
+		First(&collaborator).RowsAffected == 0 {
+		return "", false
+	}
+	return collaborator.Role, true
+}
+
+// hasFeedRole checks if the user has at least the given role on the feed.
+func hasFeedRole(db *gorm.DB, feed *model.Feed, userId string, role model.FeedCollaboratorRole) bool {
+	userRole, ok := getFeedRole(db, feed, userId)
+	return ok && feedCollaboratorRoleRank[userRole] >= feedCollaboratorRoleRank[role]
+}
+
+// canReadFeed checks if the user can read the feed. GLOBAL feeds are readable
+// by everyone, PRIVATE feeds only by users with a role on it, VIEWER included.
+func canReadFeed(db *gorm.DB, feed *model.Feed, userId string) bool {
+	return feed.Visibility == model.VisibilityGlobal ||
+		hasFeedRole(db, feed, userId, model.FeedCollaboratorRoleViewer)
+}
+
+// getEditableFeedIds returns ids of all feeds the user can update, created by
+// the user or granted EDITOR/OWNER role.
+func getEditableFeedIds(db *gorm.DB, userId string) ([]string, error) {
+	feedIds := []string{}
+	if err := db.Model(&model.Feed{}).
+		Where("creator_id = ?", userId).
+		Pluck("id", &feedIds).Error; err != nil {
+		return nil, err
+	}
+
+	var collaboratedFeedIds []string
+	if err := db.Model(&model.FeedCollaborator{}).
+		Where("user_id = ? AND role IN ?", userId, []model.FeedCollaboratorRole{
+			model.FeedCollaboratorRoleEditor, model.FeedCollaboratorRoleOwner}).
+		Pluck("feed_id", &collaboratedFeedIds).Error; err != nil {
+		return nil, err
+	}
+	return append(feedIds, collaboratedFeedIds...), nil
+}
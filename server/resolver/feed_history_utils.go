@@ -0,0 +1,109 @@
+package resolver
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/utils"
+)
+
+const (
+	defaultFeedHistoryLimit = 100
+)
+
+// recordFeedVersion snapshots feed setting as the next version of the feed,
+// it should be called in the same transaction as the change.
+func recordFeedVersion(tx *gorm.DB, feed *model.Feed, subSourceIds []string, authorId string, action model.FeedVersionAction, restoredFromVersion *int) error {
+	// Lock the feed row so that concurrent changes of the same feed take
+	// versions one after another.
+	var locked model.Feed
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", feed.Id).
+		First(&locked).Error; err != nil {
+		return err
+	}
+
+	var latest int
+	if err := tx.Model(&model.FeedVersion{}).
+		Select("COALESCE(MAX(version), 0)").
+		Where("feed_id = ?", feed.Id).
+		Scan(&latest).Error; err != nil {
+		return err
+	}
+
+	ids := append([]string{}, subSourceIds...)
+	sort.Strings(ids)
+	version := model.FeedVersion{
+		Id:                   uuid.New().String(),
+		FeedID:               feed.Id,
+		Version:              latest + 1,
+		AuthorID:             &authorId,
+		Action:               action,
+		RestoredFromVersion:  restoredFromVersion,
+		Name:                 feed.Name,
+		FilterDataExpression: feed.FilterDataExpression,
+		Visibility:           feed.Visibility,
+		SubSourceIds:         ids,
+	}
+	return tx.Omit("Feed", "Author").Create(&version).Error
+}
+
+// getFeedHistory returns the latest versions of a feed, newest first, each
+// with its diff to the previous version.
+func getFeedHistory(db *gorm.DB, feedId string, limit int) ([]*model.FeedVersion, error) {
+	if limit <= 0 || limit > defaultFeedHistoryLimit {
+		limit = defaultFeedHistoryLimit
+	}
+
+	// Fetch one more version to compute diff of the oldest returned one.
+	versions := []*model.FeedVersion{}
+	if err := db.Preload("Author").
+		Where("feed_id = ?", feedId).
+		Order("version desc").
+		Limit(limit + 1).
+		Find(&versions).Error; err != nil {
+		return nil, err
+	}
+
+	for idx := 0; idx+1 < len(versions); idx++ {
+		versions[idx].Diff = diffFeedVersions(versions[idx+1], versions[idx])
+	}
+	if len(versions) > limit {
+		versions = versions[:limit]
+	}
+	return versions, nil
+}
+
+func diffFeedVersions(previous *model.FeedVersion, current *model.FeedVersion) *model.FeedVersionDiff {
+	filterEqual, err := utils.AreJSONsEqual(previous.FilterDataExpression.String(), current.FilterDataExpression.String())
+	diff := &model.FeedVersionDiff{
+		NameChanged:                 previous.Name != current.Name,
+		FilterDataExpressionChanged: err != nil || !filterEqual,
+		VisibilityChanged:           previous.Visibility != current.Visibility,
+		AddedSubSourceIds:           []string{},
+		RemovedSubSourceIds:         []string{},
+	}
+
+	previousIds := map[string]bool{}
+	for _, id := range previous.SubSourceIds {
+		previousIds[id] = true
+	}
+	currentIds := map[string]bool{}
+	for _, id := range current.SubSourceIds {
+		currentIds[id] = true
+		if !previousIds[id] {
+			diff.AddedSubSourceIds = append(diff.AddedSubSourceIds, id)
+		}
+	}
+	for _, id := range previous.SubSourceIds {
+		if !currentIds[id] {
+			diff.RemovedSubSourceIds = append(diff.RemovedSubSourceIds, id)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,41 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+
+	"github.com/Luismorlan/newsmux/model"
+)
+
+func TestDiffFeedVersions(t *testing.T) {
+	previous := &model.FeedVersion{
+		Name:                 "feed",
+		FilterDataExpression: datatypes.JSON(`{"a": 1}`),
+		Visibility:           model.VisibilityPrivate,
+		SubSourceIds:         []string{"sub_1", "sub_2"},
+	}
+	current := &model.FeedVersion{
+		Name:                 "feed",
+		FilterDataExpression: datatypes.JSON(`{"a":1}`),
+		Visibility:           model.VisibilityPrivate,
+		SubSourceIds:         []string{"sub_1", "sub_2"},
+	}
+	assert.Equal(t, &model.FeedVersionDiff{
+		AddedSubSourceIds:   []string{},
+		RemovedSubSourceIds: []string{},
+	}, diffFeedVersions(previous, current))
+
+	current.Name = "renamed"
+	current.FilterDataExpression = datatypes.JSON(`{"a":2}`)
+	current.Visibility = model.VisibilityGlobal
+	current.SubSourceIds = []string{"sub_2", "sub_3"}
+	assert.Equal(t, &model.FeedVersionDiff{
+		NameChanged:                 true,
+		FilterDataExpressionChanged: true,
+		VisibilityChanged:           true,
+		AddedSubSourceIds:           []string{"sub_3"},
+		RemovedSubSourceIds:         []string{"sub_1"},
+	}, diffFeedVersions(previous, current))
+}
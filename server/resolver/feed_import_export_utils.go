@@ -0,0 +1,380 @@
+package resolver
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/collector"
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/protocol"
+	. "github.com/Luismorlan/newsmux/utils/log"
+)
+
+const (
+	feedsBundleVersion = 1
+	opmlVersion        = "2.0"
+	// OPML outline type of a subsource, feed outlines have no type.
+	opmlSubSourceOutlineType = "newsmux-subsource"
+)
+
+var errUnknownSource = errors.New("source not found")
+
+// feedsBundle is the JSON export format of a user's feeds. Subsources are
+// referenced by their source and external identifier instead of id, since
+// ids are different across environments.
+type feedsBundle struct {
+	Version    int                 `json:"version"`
+	ExportedAt time.Time           `json:"exportedAt"`
+	Feeds      []*feedsBundleEntry `json:"feeds"`
+}
+
+type feedsBundleEntry struct {
+	Name                 string                `json:"name"`
+	Visibility           model.Visibility      `json:"visibility"`
+	FilterDataExpression json.RawMessage       `json:"filterDataExpression"`
+	SubSources           []*subSourceReference `json:"subSources"`
+}
+
+type subSourceReference struct {
+	SourceId           string `json:"sourceId"`
+	SourceName         string `json:"sourceName"`
+	Name               string `json:"name"`
+	ExternalIdentifier string `json:"externalIdentifier"`
+	AvatarUrl          string `json:"avatarUrl,omitempty"`
+	OriginUrl          string `json:"originUrl,omitempty"`
+	// Prototext of protocol.CustomizedCrawlerParams, only exported in JSON.
+	CustomizedCrawlerParams *string `json:"customizedCrawlerParams,omitempty"`
+}
+
+// OPML only defines text/title/type/htmlUrl/xmlUrl, everything else is kept
+// in custom attributes which OPML 2.0 explicitly allows.
+type opmlDocument struct {
+	XMLName xml.Name       `xml:"opml"`
+	Version string         `xml:"version,attr"`
+	Title   string         `xml:"head>title"`
+	Created string         `xml:"head>dateCreated,omitempty"`
+	Body    []*opmlOutline `xml:"body>outline"`
+}
+
+type opmlOutline struct {
+	Text                 string         `xml:"text,attr"`
+	Title                string         `xml:"title,attr,omitempty"`
+	Type                 string         `xml:"type,attr,omitempty"`
+	HtmlUrl              string         `xml:"htmlUrl,attr,omitempty"`
+	Visibility           string         `xml:"visibility,attr,omitempty"`
+	FilterDataExpression string         `xml:"filterDataExpression,attr,omitempty"`
+	SourceId             string         `xml:"sourceId,attr,omitempty"`
+	SourceName           string         `xml:"sourceName,attr,omitempty"`
+	ExternalIdentifier   string         `xml:"externalIdentifier,attr,omitempty"`
+	AvatarUrl            string         `xml:"avatarUrl,attr,omitempty"`
+	Outlines             []*opmlOutline `xml:"outline"`
+}
+
+// buildFeedsBundle constructs export bundle from feeds with preloaded
+// SubSources, sources maps source id to source name.
+func buildFeedsBundle(feeds []*model.Feed, sources map[string]string, exportedAt time.Time) *feedsBundle {
+	bundle := &feedsBundle{
+		Version:    feedsBundleVersion,
+		ExportedAt: exportedAt,
+		Feeds:      []*feedsBundleEntry{},
+	}
+	for _, feed := range feeds {
+		entry := &feedsBundleEntry{
+			Name:                 feed.Name,
+			Visibility:           feed.Visibility,
+			FilterDataExpression: json.RawMessage(feed.FilterDataExpression),
+			SubSources:           []*subSourceReference{},
+		}
+		if len(entry.FilterDataExpression) == 0 {
+			entry.FilterDataExpression = json.RawMessage("null")
+		}
+		for _, subSource := range feed.SubSources {
+			entry.SubSources = append(entry.SubSources, &subSourceReference{
+				SourceId:                subSource.SourceID,
+				SourceName:              sources[subSource.SourceID],
+				Name:                    subSource.Name,
+				ExternalIdentifier:      subSource.ExternalIdentifier,
+				AvatarUrl:               subSource.AvatarUrl,
+				OriginUrl:               subSource.OriginUrl,
+				CustomizedCrawlerParams: subSource.CustomizedCrawlerParams,
+			})
+		}
+		bundle.Feeds = append(bundle.Feeds, entry)
+	}
+	return bundle
+}
+
+func encodeFeedsBundle(bundle *feedsBundle, format model.FeedExportFormat) (string, error) {
+	switch format {
+	case model.FeedExportFormatJSON:
+		bytes, err := json.MarshalIndent(bundle, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(bytes), nil
+	case model.FeedExportFormatOpml:
+		doc := &opmlDocument{
+			Version: opmlVersion,
+			Title:   "newsmux feeds",
+			Created: bundle.ExportedAt.UTC().Format(time.RFC1123Z),
+		}
+		for _, entry := range bundle.Feeds {
+			feedOutline := &opmlOutline{
+				Text:                 entry.Name,
+				Title:                entry.Name,
+				Visibility:           string(entry.Visibility),
+				FilterDataExpression: string(entry.FilterDataExpression),
+			}
+			for _, ref := range entry.SubSources {
+				feedOutline.Outlines = append(feedOutline.Outlines, &opmlOutline{
+					Text:               ref.Name,
+					Title:              ref.Name,
+					Type:               opmlSubSourceOutlineType,
+					HtmlUrl:            ref.OriginUrl,
+					SourceId:           ref.SourceId,
+					SourceName:         ref.SourceName,
+					ExternalIdentifier: ref.ExternalIdentifier,
+					AvatarUrl:          ref.AvatarUrl,
+				})
+			}
+			doc.Body = append(doc.Body, feedOutline)
+		}
+		bytes, err := xml.MarshalIndent(doc, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return xml.Header + string(bytes), nil
+	default:
+		return "", fmt.Errorf("unsupported export format %s", format)
+	}
+}
+
+func decodeFeedsBundle(data string, format model.FeedExportFormat) (*feedsBundle, error) {
+	switch format {
+	case model.FeedExportFormatJSON:
+		var bundle feedsBundle
+		if err := json.Unmarshal([]byte(data), &bundle); err != nil {
+			return nil, fmt.Errorf("invalid JSON feeds bundle: %v", err)
+		}
+		if bundle.Version > feedsBundleVersion {
+			return nil, fmt.Errorf("unsupported feeds bundle version %d", bundle.Version)
+		}
+		return &bundle, nil
+	case model.FeedExportFormatOpml:
+		var doc opmlDocument
+		if err := xml.Unmarshal([]byte(data), &doc); err != nil {
+			return nil, fmt.Errorf("invalid OPML: %v", err)
+		}
+		bundle := &feedsBundle{Version: feedsBundleVersion, Feeds: []*feedsBundleEntry{}}
+		for _, feedOutline := range doc.Body {
+			entry := &feedsBundleEntry{
+				Name:                 feedOutline.Text,
+				Visibility:           model.Visibility(feedOutline.Visibility),
+				FilterDataExpression: json.RawMessage(feedOutline.FilterDataExpression),
+				SubSources:           []*subSourceReference{},
+			}
+			for _, outline := range feedOutline.Outlines {
+				entry.SubSources = append(entry.SubSources, &subSourceReference{
+					SourceId:           outline.SourceId,
+					SourceName:         outline.SourceName,
+					Name:               outline.Text,
+					ExternalIdentifier: outline.ExternalIdentifier,
+					AvatarUrl:          outline.AvatarUrl,
+					OriginUrl:          outline.HtmlUrl,
+				})
+			}
+			bundle.Feeds = append(bundle.Feeds, entry)
+		}
+		return bundle, nil
+	default:
+		return nil, fmt.Errorf("unsupported import format %s", format)
+	}
+}
+
+func exportFeedsImpl(db *gorm.DB, userId string, format model.FeedExportFormat) (string, error) {
+	var feeds []*model.Feed
+	if err := db.Preload("SubSources").
+		Where("creator_id = ?", userId).
+		Order("created_at").
+		Find(&feeds).Error; err != nil {
+		return "", err
+	}
+
+	var sources []*model.Source
+	if err := db.Find(&sources).Error; err != nil {
+		return "", err
+	}
+	sourceNames := map[string]string{}
+	for _, source := range sources {
+		sourceNames[source.Id] = source.Name
+	}
+
+	return encodeFeedsBundle(buildFeedsBundle(feeds, sourceNames, time.Now()), format)
+}
+
+// importFeedsImpl creates every feed in the bundle for the user and
+// subscribes the user to it. Errors are collected per feed so that a single
+// bad entry won't fail the whole import.
+func importFeedsImpl(r *mutationResolver, ctx context.Context, input model.ImportFeedsInput) (*model.ImportFeedsResult, error) {
+	var user model.User
+	if r.DB.First(&user, "id = ?", input.UserID).RowsAffected != 1 {
+		return nil, fmt.Errorf("no valid user found %s", input.UserID)
+	}
+
+	bundle, err := decodeFeedsBundle(input.Data, input.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &model.ImportFeedsResult{
+		ImportedFeeds:  []*model.Feed{},
+		Conflicts:      []*model.ImportFeedsIssue{},
+		UnknownSources: []*model.ImportFeedsIssue{},
+		Failures:       []*model.ImportFeedsIssue{},
+	}
+	for _, entry := range bundle.Feeds {
+		if entry.Name == "" {
+			res.Failures = append(res.Failures, &model.ImportFeedsIssue{
+				FeedName: entry.Name, Message: "feed name is empty"})
+			continue
+		}
+
+		var count int64
+		r.DB.Model(&model.Feed{}).
+			Where("creator_id = ? AND name = ?", input.UserID, entry.Name).
+			Count(&count)
+		if count > 0 {
+			res.Conflicts = append(res.Conflicts, &model.ImportFeedsIssue{
+				FeedName: entry.Name,
+				Message:  "a feed with the same name already exists",
+			})
+			continue
+		}
+
+		subSourceIds := []string{}
+		for _, ref := range entry.SubSources {
+			subSource, err := resolveImportedSubSource(r.DB, ctx, ref)
+			if err == nil {
+				subSourceIds = append(subSourceIds, subSource.Id)
+				continue
+			}
+			issue := &model.ImportFeedsIssue{FeedName: entry.Name, Message: err.Error()}
+			if errors.Is(err, errUnknownSource) {
+				res.UnknownSources = append(res.UnknownSources, issue)
+			} else {
+				res.Failures = append(res.Failures, issue)
+			}
+		}
+
+		visibility := entry.Visibility
+		if !visibility.IsValid() {
+			visibility = model.VisibilityPrivate
+		}
+		dataExpression := string(entry.FilterDataExpression)
+		if dataExpression == "" {
+			dataExpression = "null"
+		}
+
+		feed, err := r.UpsertFeed(ctx, model.UpsertFeedInput{
+			UserID:               input.UserID,
+			Name:                 entry.Name,
+			FilterDataExpression: dataExpression,
+			SubSourceIds:         subSourceIds,
+			Visibility:           visibility,
+		})
+		if err != nil {
+			res.Failures = append(res.Failures, &model.ImportFeedsIssue{
+				FeedName: entry.Name, Message: err.Error()})
+			continue
+		}
+		if _, err := r.Subscribe(ctx, model.SubscribeInput{
+			UserID: input.UserID, FeedID: feed.Id}); err != nil {
+			Log.Errorf("fail to subscribe imported feed %s for user %s: %v", feed.Id, input.UserID, err)
+		}
+		res.ImportedFeeds = append(res.ImportedFeeds, feed)
+	}
+
+	if len(res.ImportedFeeds) > 0 {
+		// Subscribing new feeds updates seed state.
+		go func() {
+			r.SignalChans.PushSignalToUser(&model.Signal{
+				SignalType: model.SignalTypeSeedState}, input.UserID)
+		}()
+	}
+	return res, nil
+}
+
+// resolveImportedSubSource finds the subsource referenced in an import, or
+// creates it if the source exists but the subsource doesn't. Source is matched
+// by id first, then by name since ids are different across environments.
+func resolveImportedSubSource(db *gorm.DB, ctx context.Context, ref *subSourceReference) (*model.SubSource, error) {
+	var source model.Source
+	if db.First(&source, "id = ?", ref.SourceId).RowsAffected == 0 &&
+		(ref.SourceName == "" || db.First(&source, "name = ?", ref.SourceName).RowsAffected == 0) {
+		return nil, fmt.Errorf("%w: %s (%s) of subsource %s", errUnknownSource, ref.SourceName, ref.SourceId, ref.Name)
+	}
+
+	var subSource model.SubSource
+	query := db.Where("source_id = ?", source.Id)
+	if ref.ExternalIdentifier != "" {
+		query = query.Where("external_identifier = ?", ref.ExternalIdentifier)
+	} else {
+		query = query.Where("name = ?", ref.Name)
+	}
+	if query.First(&subSource).RowsAffected != 0 {
+		// Same as adding an existing subsource, a hidden subsource becomes
+		// visible once it's explicitly used.
+		if subSource.IsFromSharedPost {
+			if err := db.Model(&subSource).Update("is_from_shared_post", false).Error; err != nil {
+				return nil, err
+			}
+		}
+		return &subSource, nil
+	}
+
+	// Subsource without external identifier can only be resolved by name from
+	// the source website, which AddSubSourceImp knows how to do for some
+	// sources.
+	if ref.ExternalIdentifier == "" &&
+		(source.Id == collector.WeiboSourceId || source.Id == collector.TwitterSourceId) {
+		return AddSubSourceImp(db, ctx, model.AddSubSourceInput{
+			SourceID:          source.Id,
+			SubSourceUserName: ref.Name,
+		})
+	}
+
+	upsertInput := model.UpsertSubSourceInput{
+		Name:               ref.Name,
+		ExternalIdentifier: ref.ExternalIdentifier,
+		SourceID:           source.Id,
+		AvatarURL:          ref.AvatarUrl,
+		OriginURL:          ref.OriginUrl,
+		IsFromSharedPost:   false,
+	}
+	if ref.CustomizedCrawlerParams != nil {
+		var params protocol.CustomizedCrawlerParams
+		if err := prototext.Unmarshal([]byte(*ref.CustomizedCrawlerParams), &params); err != nil {
+			return nil, fmt.Errorf("invalid customized crawler params of subsource %s: %v", ref.Name, err)
+		}
+		upsertInput.CustomizedCrawlerParams = &model.CustomizedCrawlerParams{
+			CrawlURL:                   params.CrawlUrl,
+			BaseSelector:               params.BaseSelector,
+			TitleRelativeSelector:      params.TitleRelativeSelector,
+			ContentRelativeSelector:    params.ContentRelativeSelector,
+			ExternalIDRelativeSelector: params.ExternalIdRelativeSelector,
+			TimeRelativeSelector:       params.TimeRelativeSelector,
+			ImageRelativeSelector:      params.ImageRelativeSelector,
+			SubsourceRelativeSelector:  params.SubsourceRelativeSelector,
+			OriginURLRelativeSelector:  params.OriginUrlRelativeSelector,
+			OriginURLIsRelativePath:    params.OriginUrlIsRelativePath,
+		}
+	}
+	return UpsertSubsourceImpl(db, upsertInput)
+}
@@ -0,0 +1,102 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/datatypes"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/utils"
+)
+
+func TestFeedsBundleRoundTrip(t *testing.T) {
+	params := `crawl_url:"https://example.com" base_selector:".item"`
+	feeds := []*model.Feed{
+		{
+			Name:                 "feed_1",
+			Visibility:           model.VisibilityGlobal,
+			FilterDataExpression: datatypes.JSON(`{"id":"1","literal":{"pattern":"a"}}`),
+			SubSources: []*model.SubSource{
+				{SourceID: "source_1", Name: "sub_1", ExternalIdentifier: "ext_1", OriginUrl: "https://example.com/1"},
+				{SourceID: "source_2", Name: "sub_2", ExternalIdentifier: "ext_2", CustomizedCrawlerParams: &params},
+			},
+		},
+		{Name: "feed_2", Visibility: model.VisibilityPrivate},
+	}
+	bundle := buildFeedsBundle(feeds, map[string]string{"source_1": "Source 1"}, time.Now())
+
+	for _, format := range model.AllFeedExportFormat {
+		data, err := encodeFeedsBundle(bundle, format)
+		assert.Nil(t, err)
+
+		decoded, err := decodeFeedsBundle(data, format)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(decoded.Feeds))
+		assert.Equal(t, "feed_1", decoded.Feeds[0].Name)
+		assert.Equal(t, model.VisibilityGlobal, decoded.Feeds[0].Visibility)
+		assert.JSONEq(t, `{"id":"1","literal":{"pattern":"a"}}`, string(decoded.Feeds[0].FilterDataExpression))
+		assert.Equal(t, 2, len(decoded.Feeds[0].SubSources))
+		assert.Equal(t, "Source 1", decoded.Feeds[0].SubSources[0].SourceName)
+		assert.Equal(t, "ext_1", decoded.Feeds[0].SubSources[0].ExternalIdentifier)
+		assert.Equal(t, "https://example.com/1", decoded.Feeds[0].SubSources[0].OriginUrl)
+		assert.Equal(t, model.VisibilityPrivate, decoded.Feeds[1].Visibility)
+		assert.Equal(t, 0, len(decoded.Feeds[1].SubSources))
+	}
+
+	// Customized crawler params is only kept in JSON bundle.
+	data, _ := encodeFeedsBundle(bundle, model.FeedExportFormatJSON)
+	decoded, _ := decodeFeedsBundle(data, model.FeedExportFormatJSON)
+	assert.Equal(t, params, *decoded.Feeds[0].SubSources[1].CustomizedCrawlerParams)
+}
+
+func TestDecodeFeedsBundleInvalid(t *testing.T) {
+	_, err := decodeFeedsBundle("not json", model.FeedExportFormatJSON)
+	assert.NotNil(t, err)
+	_, err = decodeFeedsBundle(`{"version": 100}`, model.FeedExportFormatJSON)
+	assert.NotNil(t, err)
+	_, err = decodeFeedsBundle("<opml", model.FeedExportFormatOpml)
+	assert.NotNil(t, err)
+}
+
+func TestImportFeeds(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	r := &mutationResolver{&Resolver{DB: db, SignalChans: NewSignalChannels()}}
+
+	assert.Nil(t, db.Create(&model.User{Id: "user_id"}).Error)
+	assert.Nil(t, db.Create(&model.Source{Id: "source_id", Name: "source", CreatorID: "user_id"}).Error)
+	assert.Nil(t, db.Create(&model.Feed{Id: "feed_id", Name: "existing", CreatorID: "user_id"}).Error)
+
+	data := `{"version": 1, "feeds": [
+		{"name": "existing", "visibility": "PRIVATE", "filterDataExpression": null, "subSources": []},
+		{"name": "new", "visibility": "GLOBAL", "filterDataExpression": null, "subSources": [
+			{"sourceId": "other_env_source_id", "sourceName": "source", "name": "sub", "externalIdentifier": "ext"},
+			{"sourceId": "unknown_source_id", "sourceName": "unknown", "name": "sub", "externalIdentifier": "ext"}
+		]}
+	]}`
+	res, err := importFeedsImpl(r, context.Background(), model.ImportFeedsInput{
+		UserID: "user_id",
+		Format: model.FeedExportFormatJSON,
+		Data:   data,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res.ImportedFeeds))
+	assert.Equal(t, "new", res.ImportedFeeds[0].Name)
+	assert.Equal(t, 1, len(res.Conflicts))
+	assert.Equal(t, "existing", res.Conflicts[0].FeedName)
+	assert.Equal(t, 1, len(res.UnknownSources))
+	assert.Equal(t, 0, len(res.Failures))
+
+	var feed model.Feed
+	assert.Nil(t, db.Preload("SubSources").First(&feed, "id = ?", res.ImportedFeeds[0].Id).Error)
+	assert.Equal(t, 1, len(feed.SubSources))
+	assert.Equal(t, "source_id", feed.SubSources[0].SourceID)
+
+	var subscriptions int64
+	db.Model(&model.UserFeedSubscription{}).
+		Where("user_id = ? AND feed_id = ?", "user_id", feed.Id).
+		Count(&subscriptions)
+	assert.Equal(t, int64(1), subscriptions)
+}
@@ -0,0 +1,148 @@
+package resolver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/utils"
+	. "github.com/Luismorlan/newsmux/utils/log"
+)
+
+const (
+	feedStatsCacheTTL = 5 * time.Minute
+	// Longest time range of a single feedStats query, to bound the cost of
+	// scanning posts.
+	feedStatsMaxRange = 90 * 24 * time.Hour
+	feedStatsTopTags  = 10
+)
+
+func feedStatsCacheKey(input model.FeedStatsInput) string {
+	return fmt.Sprintf("feed_stats:%s:%d:%d:%s", input.FeedID, input.From.Unix(), input.To.Unix(), input.Bucket)
+}
+
+func validateFeedStatsInput(input model.FeedStatsInput) error {
+	if !input.From.Before(input.To) {
+		return errors.New("from must be before to")
+	}
+	if input.To.Sub(input.From) > feedStatsMaxRange {
+		return fmt.Errorf("time range can't be longer than %v", feedStatsMaxRange)
+	}
+	return nil
+}
+
+// getFeedStats returns cached stats if any, otherwise computes and caches it.
+// Failing to read or write cache is not fatal.
+func getFeedStats(db *gorm.DB, r *utils.RedisStatusStore, feed *model.Feed, input model.FeedStatsInput) (*model.FeedStats, error) {
+	if err := validateFeedStatsInput(input); err != nil {
+		return nil, err
+	}
+
+	key := feedStatsCacheKey(input)
+	if cached, ok, err := r.GetCache(key); err != nil {
+		Log.Errorf("fail to read feed stats cache %s: %v", key, err)
+	} else if ok {
+		var stats model.FeedStats
+		if err := json.Unmarshal([]byte(cached), &stats); err == nil {
+			return &stats, nil
+		}
+	}
+
+	stats, err := computeFeedStats(db, feed, input)
+	if err != nil {
+		return nil, err
+	}
+	if bytes, err := json.Marshal(stats); err == nil {
+		if err := r.SetCache(key, string(bytes), feedStatsCacheTTL); err != nil {
+			Log.Errorf("fail to write feed stats cache %s: %v", key, err)
+		}
+	}
+	return stats, nil
+}
+
+func computeFeedStats(db *gorm.DB, feed *model.Feed, input model.FeedStatsInput) (*model.FeedStats, error) {
+	stats := &model.FeedStats{
+		FeedID:     feed.Id,
+		From:       input.From,
+		To:         input.To,
+		Bucket:     input.Bucket,
+		Volume:     []*model.FeedStatsBucket{},
+		SubSources: []*model.FeedStatsSubSource{},
+		TopTags:    []*model.FeedStatsTag{},
+	}
+
+	published := func() *gorm.DB {
+		return db.Model(&model.Post{}).
+			Joins("INNER JOIN post_feed_publishes ON post_feed_publishes.post_id = posts.id").
+			Where("post_feed_publishes.feed_id = ? AND posts.created_at >= ? AND posts.created_at < ?",
+				feed.Id, input.From, input.To)
+	}
+
+	// Bucket size comes from a validated enum, safe to be inlined in SQL.
+	truncated := fmt.Sprintf("date_trunc('%s', posts.created_at)", strings.ToLower(input.Bucket.String()))
+	if err := published().
+		Select(truncated + " AS start, count(*) AS count").
+		Group(truncated).
+		Order("start").
+		Scan(&stats.Volume).Error; err != nil {
+		return nil, err
+	}
+
+	if err := published().
+		Select("sub_sources.id AS sub_source_id, sub_sources.name AS name, count(*) AS count").
+		Joins("INNER JOIN sub_sources ON sub_sources.id = posts.sub_source_id").
+		Group("sub_sources.id, sub_sources.name").
+		Order("count DESC").
+		Scan(&stats.SubSources).Error; err != nil {
+		return nil, err
+	}
+
+	if err := published().
+		Select("posts.tag AS tag, count(*) AS count").
+		Where("posts.tag != ''").
+		Group("posts.tag").
+		Order("count DESC").
+		Limit(feedStatsTopTags).
+		Scan(&stats.TopTags).Error; err != nil {
+		return nil, err
+	}
+
+	var publishedCount int64
+	if err := published().Count(&publishedCount).Error; err != nil {
+		return nil, err
+	}
+	stats.PublishedCount = int(publishedCount)
+
+	// Candidates are all posts the feed could have published, i.e. root posts
+	// crawled from its subsources.
+	var candidateCount int64
+	if err := db.Model(&model.Post{}).
+		Joins("INNER JOIN feed_subsources ON feed_subsources.sub_source_id = posts.sub_source_id").
+		Where("feed_subsources.feed_id = ? AND posts.in_sharing_chain = ?", feed.Id, false).
+		Where("posts.created_at >= ? AND posts.created_at < ?", input.From, input.To).
+		Count(&candidateCount).Error; err != nil {
+		return nil, err
+	}
+	stats.CandidateCount = int(candidateCount)
+	stats.MatchRate = feedStatsMatchRate(stats.PublishedCount, stats.CandidateCount)
+
+	return stats, nil
+}
+
+func feedStatsMatchRate(published int, candidates int) float64 {
+	if candidates == 0 {
+		return 0
+	}
+	rate := float64(published) / float64(candidates)
+	// Posts published before a subsource is removed from the feed are not
+	// candidates anymore.
+	if rate > 1 {
+		return 1
+	}
+	return rate
+}
@@ -0,0 +1,23 @@
+package resolver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Luismorlan/newsmux/model"
+)
+
+func TestValidateFeedStatsInput(t *testing.T) {
+	now := time.Now()
+	assert.Nil(t, validateFeedStatsInput(model.FeedStatsInput{From: now.Add(-time.Hour), To: now}))
+	assert.NotNil(t, validateFeedStatsInput(model.FeedStatsInput{From: now, To: now}))
+	assert.NotNil(t, validateFeedStatsInput(model.FeedStatsInput{From: now.Add(-feedStatsMaxRange - time.Hour), To: now}))
+}
+
+func TestFeedStatsMatchRate(t *testing.T) {
+	assert.Equal(t, 0.0, feedStatsMatchRate(0, 0))
+	assert.Equal(t, 0.25, feedStatsMatchRate(1, 4))
+	assert.Equal(t, 1.0, feedStatsMatchRate(5, 4))
+}
@@ -0,0 +1,74 @@
+package resolver
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/model"
+)
+
+func getUserMutes(db *gorm.DB, userId string) ([]*model.UserMute, error) {
+	mutes := []*model.UserMute{}
+	err := db.Where("user_id = ?", userId).Order("created_at").Find(&mutes).Error
+	return mutes, err
+}
+
+// muteScope excludes muted posts when querying published posts, so that
+// muted posts don't count towards the query limit.
+func muteScope(mutes []*model.UserMute) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, mute := range mutes {
+			switch mute.Type {
+			case model.MuteTypeSubsource:
+				// Posts of deleted subsources have NULL sub_source_id, which
+				// != never matches.
+				db = db.Where("posts.sub_source_id IS DISTINCT FROM ?", mute.Target)
+			case model.MuteTypePost:
+				db = db.Where("posts.id != ?", mute.Target)
+			case model.MuteTypeKeyword:
+				// strpos instead of LIKE so that keyword needs no escaping.
+				db = db.Where("strpos(lower(posts.title || ' ' || posts.content), lower(?)) = 0", mute.Target)
+			}
+		}
+		return db
+	}
+}
+
+// isPostMuted checks the post and the post it's shared from against mutes.
+func isPostMuted(post *model.Post, mutes []*model.UserMute) bool {
+	for _, mute := range mutes {
+		switch mute.Type {
+		case model.MuteTypeSubsource:
+			if post.SubSourceID == mute.Target {
+				return true
+			}
+		case model.MuteTypePost:
+			if post.Id == mute.Target {
+				return true
+			}
+		case model.MuteTypeKeyword:
+			text := strings.ToLower(post.Title + " " + post.Content)
+			if strings.Contains(text, strings.ToLower(mute.Target)) {
+				return true
+			}
+		}
+	}
+	if post.SharedFromPost != nil {
+		return isPostMuted(post.SharedFromPost, mutes)
+	}
+	return false
+}
+
+func filterMutedPosts(posts []*model.Post, mutes []*model.UserMute) []*model.Post {
+	if len(mutes) == 0 {
+		return posts
+	}
+	res := []*model.Post{}
+	for _, post := range posts {
+		if !isPostMuted(post, mutes) {
+			res = append(res, post)
+		}
+	}
+	return res
+}
@@ -0,0 +1,44 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/model"
+)
+
+func TestFilterMutedPosts(t *testing.T) {
+	posts := []*model.Post{
+		{Id: "post_1", SubSourceID: "noisy_subsource", Content: "hello"},
+		{Id: "post_2", SubSourceID: "subsource", Content: "Breaking: SPAM inside"},
+		{Id: "post_3", SubSourceID: "subsource", Content: "hidden by user"},
+		{Id: "post_4", SubSourceID: "subsource", Content: "share",
+			SharedFromPost: &model.Post{Id: "post_5", SubSourceID: "noisy_subsource"}},
+		{Id: "post_6", SubSourceID: "subsource", Title: "normal", Content: "normal"},
+	}
+
+	assert.Equal(t, posts, filterMutedPosts(posts, []*model.UserMute{}))
+
+	res := filterMutedPosts(posts, []*model.UserMute{
+		{Type: model.MuteTypeSubsource, Target: "noisy_subsource"},
+		{Type: model.MuteTypeKeyword, Target: "spam"},
+		{Type: model.MuteTypePost, Target: "post_3"},
+	})
+	assert.Equal(t, 1, len(res))
+	assert.Equal(t, "post_6", res[0].Id)
+}
+
+func TestMuteScopeKeepsPostsWithoutSubSource(t *testing.T) {
+	db, err := gorm.Open(postgres.Open("host=localhost"), &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	require.Nil(t, err)
+
+	var posts []*model.Post
+	stmt := db.Model(&model.Post{}).
+		Scopes(muteScope([]*model.UserMute{{Type: model.MuteTypeSubsource, Target: "noisy_subsource"}})).
+		Find(&posts).Statement
+	assert.Contains(t, stmt.SQL.String(), "posts.sub_source_id IS DISTINCT FROM $1")
+}
@@ -0,0 +1,84 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/server/middlewares"
+)
+
+var scopeByPersonalAccessTokenScope = map[model.PersonalAccessTokenScope]string{
+	model.PersonalAccessTokenScopeRead:       middlewares.ScopeRead,
+	model.PersonalAccessTokenScopeFeedsWrite: middlewares.ScopeFeedsWrite,
+}
+
+// personalAccessTokenManagementFields can't be called by scoped identities, so
+// that a leaked token can't be used to mint more tokens.
+var personalAccessTokenManagementFields = map[string]bool{
+	"createPersonalAccessToken": true,
+	"revokePersonalAccessToken": true,
+	"personalAccessTokens":      true,
+}
+
+func toScopes(scopes []model.PersonalAccessTokenScope) ([]string, error) {
+	if len(scopes) == 0 {
+		return nil, errors.New("personal access token must have at least one scope")
+	}
+	res := []string{}
+	for _, scope := range scopes {
+		s, ok := scopeByPersonalAccessTokenScope[scope]
+		if !ok {
+			return nil, fmt.Errorf("unknown scope %s", scope)
+		}
+		res = append(res, s)
+	}
+	return res, nil
+}
+
+func fromScopes(scopes []string) []model.PersonalAccessTokenScope {
+	res := []model.PersonalAccessTokenScope{}
+	for _, s := range scopes {
+		for scope, str := range scopeByPersonalAccessTokenScope {
+			if str == s {
+				res = append(res, scope)
+			}
+		}
+	}
+	return res
+}
+
+// ScopeFieldMiddleware rejects root fields not granted to the caller's scopes,
+// queries and subscriptions need read, mutations need feeds:write. Identities
+// without scopes (browser login) are not restricted.
+func ScopeFieldMiddleware(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Parent != nil {
+		return next(ctx)
+	}
+	identity, ok := middlewares.IdentityFromContext(ctx)
+	if !ok || identity.Scopes == nil {
+		return next(ctx)
+	}
+	if err := checkScope(identity, fc.Object, fc.Field.Name); err != nil {
+		return nil, err
+	}
+	return next(ctx)
+}
+
+func checkScope(identity *middlewares.Identity, object string, field string) error {
+	if identity.Scopes != nil && personalAccessTokenManagementFields[field] {
+		return fmt.Errorf("%s is not allowed with a scoped token", field)
+	}
+	scope := middlewares.ScopeRead
+	if object == "Mutation" {
+		scope = middlewares.ScopeFeedsWrite
+	}
+	if !identity.HasScope(scope) {
+		return fmt.Errorf("%s requires scope %s", field, scope)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/server/middlewares"
+)
+
+func TestPersonalAccessTokenScopesRoundTrip(t *testing.T) {
+	scopes, err := toScopes(model.AllPersonalAccessTokenScope)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{middlewares.ScopeRead, middlewares.ScopeFeedsWrite}, scopes)
+	assert.Equal(t, model.AllPersonalAccessTokenScope, fromScopes(scopes))
+
+	_, err = toScopes(nil)
+	assert.NotNil(t, err)
+	_, err = toScopes([]model.PersonalAccessTokenScope{"ADMIN"})
+	assert.NotNil(t, err)
+}
+
+func TestCheckScope(t *testing.T) {
+	login := &middlewares.Identity{Subject: "user_id"}
+	readOnly := &middlewares.Identity{Subject: "user_id", Scopes: []string{middlewares.ScopeRead}}
+	writer := &middlewares.Identity{Subject: "user_id", Scopes: []string{middlewares.ScopeFeedsWrite}}
+
+	assert.Nil(t, checkScope(login, "Mutation", "upsertFeed"))
+	assert.Nil(t, checkScope(login, "Mutation", "createPersonalAccessToken"))
+
+	assert.Nil(t, checkScope(readOnly, "Query", "userState"))
+	assert.Nil(t, checkScope(readOnly, "Subscription", "signal"))
+	assert.NotNil(t, checkScope(readOnly, "Mutation", "upsertFeed"))
+
+	assert.Nil(t, checkScope(writer, "Query", "userState"))
+	assert.Nil(t, checkScope(writer, "Mutation", "upsertFeed"))
+	assert.NotNil(t, checkScope(writer, "Mutation", "createPersonalAccessToken"))
+	assert.NotNil(t, checkScope(writer, "Query", "personalAccessTokens"))
+}
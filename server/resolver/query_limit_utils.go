@@ -0,0 +1,141 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/server/graph/generated"
+	"github.com/Luismorlan/newsmux/utils"
+	. "github.com/Luismorlan/newsmux/utils/log"
+)
+
+const (
+	// MaxQueryComplexity caps the estimated cost of a single operation, a
+	// typical feeds query of 20 feeds costs a few thousands.
+	MaxQueryComplexity = 20000
+
+	// Hard caps on list sizes.
+	maxFeedRefreshInputs = 50
+	postsQueryLimit      = 100
+
+	// Estimated length of lists whose size isn't known before resolving, feed
+	// posts are estimated lower than feedRefreshLimit since clients page them.
+	estimatedFeedPostsSize = 20
+	estimatedListSize      = 10
+
+	// tryCustomizedCrawler makes outbound http requests, it's priced as a
+	// large query on its own.
+	tryCustomizedCrawlerComplexity = 2000
+
+	// Error codes in GraphQL error extensions, for clients to back off without
+	// parsing messages.
+	errorCodeRateLimited = "RATE_LIMITED"
+	errorCodeListTooLong = "LIST_TOO_LONG"
+)
+
+// tryCustomizedCrawlerRateLimit is per user, on top of the http rate limits.
+var tryCustomizedCrawlerRateLimit = utils.RateLimit{Rate: 0.2, Burst: 5}
+
+// NewComplexityRoot returns per-field costs used by complexity limit, fields
+// not listed cost 1 plus their children.
+func NewComplexityRoot() generated.ComplexityRoot {
+	var c generated.ComplexityRoot
+	list := func(childComplexity int) int {
+		return estimatedListSize * childComplexity
+	}
+
+	c.Query.Feeds = func(childComplexity int, input *model.FeedsGetPostsInput) int {
+		// Without refresh inputs, all subscribed feeds are returned with their
+		// initial page of posts.
+		if input == nil || len(input.FeedRefreshInputs) == 0 {
+			return estimatedListSize * childComplexity
+		}
+		// Child complexity prices estimatedFeedPostsSize posts per feed, scale
+		// it by the requested limits. Smaller pages are still priced as a full
+		// estimated page for the feed's own fields.
+		posts := 0
+		for _, refresh := range input.FeedRefreshInputs {
+			limit := refresh.Limit
+			if limit > feedRefreshLimit {
+				limit = feedRefreshLimit
+			}
+			if limit < estimatedFeedPostsSize {
+				limit = estimatedFeedPostsSize
+			}
+			posts += limit
+		}
+		return posts * childComplexity / estimatedFeedPostsSize
+	}
+	c.Query.Posts = func(childComplexity int) int {
+		return postsQueryLimit * childComplexity
+	}
+	c.Query.TryCustomizedCrawler = func(childComplexity int, input *model.CustomizedCrawlerParams) int {
+		return tryCustomizedCrawlerComplexity + childComplexity
+	}
+	c.Query.AllVisibleFeeds = list
+	c.Query.Users = list
+	c.Query.Sources = func(childComplexity int, input *model.SourcesInput) int {
+		return list(childComplexity)
+	}
+	c.Query.SubSources = func(childComplexity int, input *model.SubsourcesInput) int {
+		return list(childComplexity)
+	}
+
+	c.Feed.Posts = func(childComplexity int) int {
+		return estimatedFeedPostsSize * childComplexity
+	}
+	c.Feed.SubSources = list
+	c.Feed.Subscribers = list
+	c.Source.SubSources = list
+	c.User.SubscribedFeeds = list
+	c.User.SavedPosts = list
+	c.Post.PublishedFeeds = list
+	c.Post.SavedByUser = list
+	return c
+}
+
+// newCodedError returns an error on the current field path with a machine
+// readable code in extensions.
+func newCodedError(ctx context.Context, code string, message string, extensions map[string]interface{}) *gqlerror.Error {
+	if extensions == nil {
+		extensions = map[string]interface{}{}
+	}
+	extensions["code"] = code
+	return &gqlerror.Error{
+		Path:       graphql.GetPath(ctx),
+		Message:    message,
+		Extensions: extensions,
+	}
+}
+
+func validateFeedsGetPostsInput(ctx context.Context, input *model.FeedsGetPostsInput) error {
+	if len(input.FeedRefreshInputs) > maxFeedRefreshInputs {
+		return newCodedError(ctx, errorCodeListTooLong,
+			fmt.Sprintf("at most %d feeds can be refreshed at once", maxFeedRefreshInputs),
+			map[string]interface{}{"max": maxFeedRefreshInputs})
+	}
+	return nil
+}
+
+// allowFieldRequest applies a per-user rate limit to an expensive field.
+// Limiter failure is logged and the request is allowed.
+func allowFieldRequest(ctx context.Context, store *utils.RedisStatusStore, field string, userId string, limit utils.RateLimit) error {
+	if store == nil {
+		return nil
+	}
+	allowed, retryAfter, err := store.AllowRequest(field, userId, limit)
+	if err != nil {
+		Log.Errorf("fail to check rate limit of %s for user %s: %v", field, userId, err)
+		return nil
+	}
+	if !allowed {
+		return newCodedError(ctx, errorCodeRateLimited, "too many requests",
+			map[string]interface{}{"retryAfterSeconds": int(math.Ceil(retryAfter.Seconds()))})
+	}
+	return nil
+}
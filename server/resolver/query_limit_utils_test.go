@@ -0,0 +1,78 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/server/graph/generated"
+)
+
+const feedsQueryPostFields = `
+	id title content cursor imageUrls originUrl contentGeneratedAt
+	subSource { id name avatarUrl }
+	sharedFromPost { id title content imageUrls subSource { id name avatarUrl } }
+`
+
+func queryComplexity(t *testing.T, query string) int {
+	es := generated.NewExecutableSchema(generated.Config{
+		Resolvers:  &Resolver{},
+		Complexity: NewComplexityRoot(),
+	})
+	doc, err := gqlparser.LoadQuery(es.Schema(), query)
+	require.Nil(t, err)
+	return complexity.Calculate(es, doc.Operations[0], map[string]interface{}{})
+}
+
+func feedsQuery(feeds int, limit int) string {
+	inputs := []string{}
+	for i := 0; i < feeds; i++ {
+		inputs = append(inputs, fmt.Sprintf(`{feedId: "feed_%d", limit: %d, cursor: -1, direction: NEW}`, i, limit))
+	}
+	return fmt.Sprintf(`query {
+		feeds(input: {userId: "user_id", feedRefreshInputs: [%s]}) {
+			id name updatedAt
+			posts { %s }
+		}
+	}`, strings.Join(inputs, ","), feedsQueryPostFields)
+}
+
+func TestQueryComplexity(t *testing.T) {
+	assert.Less(t, queryComplexity(t, feedsQuery(20, 20)), MaxQueryComplexity)
+	assert.Greater(t, queryComplexity(t, feedsQuery(maxFeedRefreshInputs, 20)), MaxQueryComplexity)
+
+	// Complexity grows with requested limits, not only with number of feeds.
+	assert.Less(t, queryComplexity(t, feedsQuery(1, feedRefreshLimit)), MaxQueryComplexity)
+	assert.Greater(t, queryComplexity(t, feedsQuery(10, feedRefreshLimit)), MaxQueryComplexity)
+	assert.Greater(t, queryComplexity(t, feedsQuery(maxFeedRefreshInputs, feedRefreshLimit)),
+		10*queryComplexity(t, feedsQuery(maxFeedRefreshInputs, 20)))
+
+	// Outbound requests are priced regardless of selection.
+	assert.GreaterOrEqual(t, queryComplexity(t, `query {
+		tryCustomizedCrawler(input: {crawlUrl: "https://example.com", baseSelector: ".a"}) { title }
+	}`), tryCustomizedCrawlerComplexity)
+}
+
+func TestValidateFeedsGetPostsInput(t *testing.T) {
+	input := &model.FeedsGetPostsInput{UserID: "user_id"}
+	for i := 0; i < maxFeedRefreshInputs; i++ {
+		input.FeedRefreshInputs = append(input.FeedRefreshInputs, &model.FeedRefreshInput{})
+	}
+	assert.Nil(t, validateFeedsGetPostsInput(context.Background(), input))
+
+	input.FeedRefreshInputs = append(input.FeedRefreshInputs, &model.FeedRefreshInput{})
+	err := validateFeedsGetPostsInput(context.Background(), input)
+	require.NotNil(t, err)
+	var gqlErr *gqlerror.Error
+	require.True(t, errors.As(err, &gqlErr))
+	assert.Equal(t, errorCodeListTooLong, gqlErr.Extensions["code"])
+}
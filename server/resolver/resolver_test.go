@@ -1,18 +1,22 @@
 package resolver
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/stretchr/testify/require"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 
 	"github.com/Luismorlan/newsmux/model"
 	"github.com/Luismorlan/newsmux/server/graph/generated"
+	"github.com/Luismorlan/newsmux/server/middlewares"
 	"github.com/Luismorlan/newsmux/utils"
 	"github.com/Luismorlan/newsmux/utils/dotenv"
 )
@@ -23,14 +27,24 @@ func TestMain(m *testing.M) {
 }
 
 func PrepareTestForGraphQLAPIs(db *gorm.DB, redis *utils.RedisStatusStore) *client.Client {
-	client := client.New(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{
+	srv := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &Resolver{
 		DB:               db,
 		RedisStatusStore: redis,
 		SignalChans:      NewSignalChannels(),
-	}})))
+	}, Complexity: NewComplexityRoot()}))
+	srv.Use(extension.FixedComplexityLimit(MaxQueryComplexity))
+	srv.AroundFields(ScopeFieldMiddleware)
+	client := client.New(srv, withIdentity(&middlewares.Identity{BypassAuth: true}))
 	return client
 }
 
+// withIdentity sends the request as if it's authenticated as identity.
+func withIdentity(identity *middlewares.Identity) client.Option {
+	return func(bd *client.Request) {
+		bd.HTTP = bd.HTTP.WithContext(middlewares.WithIdentity(bd.HTTP.Context(), identity))
+	}
+}
+
 func TestCreateUser(t *testing.T) {
 	db, _ := utils.CreateTempDB(t)
 	redis, _ := utils.GetRedisStatusStore()
@@ -177,6 +191,398 @@ func TestSubscriberCount(t *testing.T) {
 	utils.TestGetSubscriberCountAndValidate(t, feedId1, 2, db, client)
 }
 
+func TestFeedHistory(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	redis, _ := utils.GetRedisStatusStore()
+
+	client := PrepareTestForGraphQLAPIs(db, redis)
+
+	userId := utils.TestCreateUserAndValidate(t, "test_user_name", "history_user_id", db, client)
+	sourceId := utils.TestCreateSourceAndValidate(t, userId, "test_source_for_history", "test_domain", db, client)
+	subSourceId := utils.TestCreateSubSourceAndValidate(t, userId, "test_subsource_for_history", "test_externalid", sourceId, false, db, client)
+	feedId, _ := utils.TestCreateFeedAndValidate(t, userId, "history_feed", `{"a":1}`, []string{subSourceId}, model.VisibilityPrivate, db, client)
+
+	var updated struct {
+		UpsertFeed struct {
+			Id string `json:"id"`
+		} `json:"upsertFeed"`
+	}
+	client.MustPost(fmt.Sprintf(`mutation {
+		upsertFeed(input: {feedId: "%s", userId: "%s", name: "renamed", filterDataExpression: "{\"a\":2}", subSourceIds: [], visibility: PRIVATE}) {
+			id
+		}
+	}`, feedId, userId), &updated)
+
+	type feedVersion struct {
+		Version             int    `json:"version"`
+		Action              string `json:"action"`
+		RestoredFromVersion *int   `json:"restoredFromVersion"`
+		Name                string `json:"name"`
+		Author              struct {
+			Id string `json:"id"`
+		} `json:"author"`
+		Diff *struct {
+			NameChanged                 bool     `json:"nameChanged"`
+			FilterDataExpressionChanged bool     `json:"filterDataExpressionChanged"`
+			RemovedSubSourceIds         []string `json:"removedSubSourceIds"`
+		} `json:"diff"`
+	}
+	var history struct {
+		FeedHistory []feedVersion `json:"feedHistory"`
+	}
+	query := fmt.Sprintf(`query {
+		feedHistory(input: {userId: "%s", feedId: "%s"}) {
+			version action restoredFromVersion name
+			author { id }
+			diff { nameChanged filterDataExpressionChanged removedSubSourceIds }
+		}
+	}`, userId, feedId)
+	client.MustPost(query, &history)
+	require.Equal(t, 2, len(history.FeedHistory))
+	require.Equal(t, 2, history.FeedHistory[0].Version)
+	require.Equal(t, "UPDATE", history.FeedHistory[0].Action)
+	require.Equal(t, userId, history.FeedHistory[0].Author.Id)
+	require.True(t, history.FeedHistory[0].Diff.NameChanged)
+	require.True(t, history.FeedHistory[0].Diff.FilterDataExpressionChanged)
+	require.Equal(t, []string{subSourceId}, history.FeedHistory[0].Diff.RemovedSubSourceIds)
+	require.Equal(t, "CREATE", history.FeedHistory[1].Action)
+	require.Nil(t, history.FeedHistory[1].Diff)
+
+	var restored struct {
+		RestoreFeedVersion struct {
+			Name       string `json:"name"`
+			SubSources []struct {
+				Id string `json:"id"`
+			} `json:"subSources"`
+		} `json:"restoreFeedVersion"`
+	}
+	client.MustPost(fmt.Sprintf(`mutation {
+		restoreFeedVersion(input: {userId: "%s", feedId: "%s", version: 1}) {
+			name
+			subSources { id }
+		}
+	}`, userId, feedId), &restored)
+	require.Equal(t, "history_feed", restored.RestoreFeedVersion.Name)
+	require.Equal(t, 1, len(restored.RestoreFeedVersion.SubSources))
+
+	client.MustPost(query, &history)
+	require.Equal(t, 3, len(history.FeedHistory))
+	require.Equal(t, "RESTORE", history.FeedHistory[0].Action)
+	require.Equal(t, 1, *history.FeedHistory[0].RestoredFromVersion)
+
+	// Renaming through syncUp is recorded as well.
+	var synced struct {
+		SyncUp struct {
+			FeedSeedState []struct {
+				Name string `json:"name"`
+			} `json:"feedSeedState"`
+		} `json:"syncUp"`
+	}
+	client.MustPost(fmt.Sprintf(`mutation {
+		syncUp(input: {userSeedState: {id: "%s", name: "test_user_name", avatarUrl: ""}, feedSeedState: [{id: "%s", name: "renamed_in_seed_state"}]}) {
+			feedSeedState { name }
+		}
+	}`, userId, feedId), &synced)
+	client.MustPost(query, &history)
+	require.Equal(t, 4, len(history.FeedHistory))
+	require.Equal(t, "UPDATE", history.FeedHistory[0].Action)
+	require.Equal(t, "renamed_in_seed_state", history.FeedHistory[0].Name)
+	require.True(t, history.FeedHistory[0].Diff.NameChanged)
+	require.Equal(t, []string{}, history.FeedHistory[0].Diff.RemovedSubSourceIds)
+}
+
+func TestCloneFeed(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	redis, _ := utils.GetRedisStatusStore()
+
+	client := PrepareTestForGraphQLAPIs(db, redis)
+
+	ownerId := utils.TestCreateUserAndValidate(t, "test_user_name", "owner_user_id", db, client)
+	userId := utils.TestCreateUserAndValidate(t, "test_user_name", "clone_user_id", db, client)
+	sourceId := utils.TestCreateSourceAndValidate(t, ownerId, "test_source_for_clone", "test_domain", db, client)
+	subSourceId := utils.TestCreateSubSourceAndValidate(t, ownerId, "test_subsource_for_clone", "test_externalid", sourceId, false, db, client)
+	feedId, _ := utils.TestCreateFeedAndValidate(t, ownerId, "test_feed_for_clone", `{"a":1}`, []string{subSourceId}, model.VisibilityGlobal, db, client)
+	utils.TestCreatePostAndValidate(t, "test_title", "test_content", subSourceId, feedId, db, client)
+
+	var resp struct {
+		CloneFeed struct {
+			Id               string `json:"id"`
+			Name             string `json:"name"`
+			Visibility       string `json:"visibility"`
+			ClonedFromFeedId string `json:"clonedFromFeedId"`
+			SubSources       []struct {
+				Id string `json:"id"`
+			} `json:"subSources"`
+		} `json:"cloneFeed"`
+	}
+	client.MustPost(fmt.Sprintf(`mutation {
+		cloneFeed(input: {feedId: "%s", userId: "%s", name: "my_clone", copyPosts: true}) {
+			id
+			name
+			visibility
+			clonedFromFeedId
+			subSources {
+				id
+			}
+		}
+	}`, feedId, userId), &resp)
+
+	require.NotEqual(t, feedId, resp.CloneFeed.Id)
+	require.Equal(t, "my_clone", resp.CloneFeed.Name)
+	require.Equal(t, string(model.VisibilityPrivate), resp.CloneFeed.Visibility)
+	require.Equal(t, feedId, resp.CloneFeed.ClonedFromFeedId)
+	require.Equal(t, 1, len(resp.CloneFeed.SubSources))
+	require.Equal(t, subSourceId, resp.CloneFeed.SubSources[0].Id)
+
+	var publishCount int64
+	db.Model(&model.PostFeedPublish{}).Where("feed_id = ?", resp.CloneFeed.Id).Count(&publishCount)
+	require.Equal(t, int64(1), publishCount)
+
+	var subscriptionCount int64
+	db.Model(&model.UserFeedSubscription{}).
+		Where("user_id = ? AND feed_id = ?", userId, resp.CloneFeed.Id).
+		Count(&subscriptionCount)
+	require.Equal(t, int64(1), subscriptionCount)
+
+	var countResp struct {
+		AllVisibleFeeds []struct {
+			Id         string `json:"id"`
+			CloneCount int    `json:"cloneCount"`
+		} `json:"allVisibleFeeds"`
+	}
+	client.MustPost(`query {
+		allVisibleFeeds {
+			id
+			cloneCount
+		}
+	}`, &countResp)
+	require.Equal(t, 1, len(countResp.AllVisibleFeeds))
+	require.Equal(t, 1, countResp.AllVisibleFeeds[0].CloneCount)
+}
+
+func TestPersonalAccessTokens(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	redis, _ := utils.GetRedisStatusStore()
+
+	client := PrepareTestForGraphQLAPIs(db, redis)
+	userId := utils.TestCreateUserAndValidate(t, "test_user_name", "pat_user_id", db, client)
+
+	var created struct {
+		CreatePersonalAccessToken struct {
+			Token               string `json:"token"`
+			PersonalAccessToken struct {
+				Id     string   `json:"id"`
+				Scopes []string `json:"scopes"`
+			} `json:"personalAccessToken"`
+		} `json:"createPersonalAccessToken"`
+	}
+	require.Nil(t, client.Post(fmt.Sprintf(`mutation {
+		createPersonalAccessToken(input: {userId: "%s", name: "bot", scopes: [READ]}) {
+			token
+			personalAccessToken { id scopes }
+		}
+	}`, userId), &created))
+	token := created.CreatePersonalAccessToken.Token
+	tokenId := created.CreatePersonalAccessToken.PersonalAccessToken.Id
+	require.Equal(t, []string{"READ"}, created.CreatePersonalAccessToken.PersonalAccessToken.Scopes)
+
+	verifier := &middlewares.PersonalAccessTokenVerifier{DB: db}
+	identity, err := verifier.Verify(context.Background(), token)
+	require.Nil(t, err)
+	require.Equal(t, userId, identity.Subject)
+	require.Equal(t, []string{middlewares.ScopeRead}, identity.Scopes)
+
+	var tokens struct {
+		PersonalAccessTokens []struct {
+			Id         string  `json:"id"`
+			LastUsedAt *string `json:"lastUsedAt"`
+		} `json:"personalAccessTokens"`
+	}
+	require.Nil(t, client.Post(fmt.Sprintf(`query {
+		personalAccessTokens(input: {userId: "%s"}) { id lastUsedAt }
+	}`, userId), &tokens))
+	require.Equal(t, 1, len(tokens.PersonalAccessTokens))
+	require.NotNil(t, tokens.PersonalAccessTokens[0].LastUsedAt)
+
+	// Read only token can't mutate.
+	err = client.Post(`mutation { createUser(input: {id: "pat_user_id", name: "x"}) { id } }`, &struct{}{},
+		withIdentity(identity))
+	require.Contains(t, fmt.Sprint(err), "requires scope feeds:write")
+
+	var revoked struct {
+		RevokePersonalAccessToken struct {
+			RevokedAt *string `json:"revokedAt"`
+		} `json:"revokePersonalAccessToken"`
+	}
+	require.Nil(t, client.Post(fmt.Sprintf(`mutation {
+		revokePersonalAccessToken(input: {userId: "%s", tokenId: "%s"}) { revokedAt }
+	}`, userId, tokenId), &revoked))
+	require.NotNil(t, revoked.RevokePersonalAccessToken.RevokedAt)
+
+	_, err = verifier.Verify(context.Background(), token)
+	require.NotNil(t, err)
+}
+
+func TestFeedCollaborators(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	redis, _ := utils.GetRedisStatusStore()
+
+	client := PrepareTestForGraphQLAPIs(db, redis)
+
+	ownerId := utils.TestCreateUserAndValidate(t, "test_user_name", "owner_user_id", db, client)
+	editorId := utils.TestCreateUserAndValidate(t, "test_user_name", "editor_user_id", db, client)
+	viewerId := utils.TestCreateUserAndValidate(t, "test_user_name", "viewer_user_id", db, client)
+	strangerId := utils.TestCreateUserAndValidate(t, "test_user_name", "stranger_user_id", db, client)
+	feedId, _ := utils.TestCreateFeedAndValidate(t, ownerId, "test_feed_for_collaborators", `{"a":1}`, []string{}, model.VisibilityPrivate, db, client)
+
+	addCollaborator := func(userId string, collaboratorId string, role model.FeedCollaboratorRole) error {
+		var resp struct {
+			AddFeedCollaborator struct {
+				Role string `json:"role"`
+			} `json:"addFeedCollaborator"`
+		}
+		return client.Post(fmt.Sprintf(`mutation {
+			addFeedCollaborator(input: {userId: "%s", feedId: "%s", collaboratorId: "%s", role: %s}) {
+				role
+			}
+		}`, userId, feedId, collaboratorId, role), &resp)
+	}
+	upsertFeed := func(userId string, name string) error {
+		var resp struct {
+			UpsertFeed struct {
+				Id string `json:"id"`
+			} `json:"upsertFeed"`
+		}
+		return client.Post(fmt.Sprintf(`mutation {
+			upsertFeed(input: {feedId: "%s", userId: "%s", name: "%s", filterDataExpression: "{\"a\":1}", subSourceIds: [], visibility: PRIVATE}) {
+				id
+			}
+		}`, feedId, userId, name), &resp)
+	}
+
+	require.Nil(t, addCollaborator(ownerId, editorId, model.FeedCollaboratorRoleEditor))
+	require.Nil(t, addCollaborator(ownerId, viewerId, model.FeedCollaboratorRoleViewer))
+	// Only owner can manage collaborators.
+	require.NotNil(t, addCollaborator(editorId, viewerId, model.FeedCollaboratorRoleOwner))
+
+	// Editor can update the feed without taking over the ownership, viewer
+	// can't update.
+	require.Nil(t, upsertFeed(editorId, "renamed_by_editor"))
+	require.NotNil(t, upsertFeed(viewerId, "renamed_by_viewer"))
+	var feed model.Feed
+	db.First(&feed, "id = ?", feedId)
+	require.Equal(t, "renamed_by_editor", feed.Name)
+	require.Equal(t, ownerId, feed.CreatorID)
+
+	var stateResp struct {
+		UserState struct {
+			EditableFeedIds []string `json:"editableFeedIds"`
+		} `json:"userState"`
+	}
+	client.MustPost(fmt.Sprintf(`query {
+		userState(input: {userId: "%s"}) {
+			editableFeedIds
+		}
+	}`, editorId), &stateResp)
+	require.Equal(t, []string{feedId}, stateResp.UserState.EditableFeedIds)
+	client.MustPost(fmt.Sprintf(`query {
+		userState(input: {userId: "%s"}) {
+			editableFeedIds
+		}
+	}`, viewerId), &stateResp)
+	require.Equal(t, []string{}, stateResp.UserState.EditableFeedIds)
+
+	// Viewer can subscribe to and read the PRIVATE feed, other users can't.
+	queryFeed := func(userId string) error {
+		var resp struct {
+			Feeds []struct {
+				Id string `json:"id"`
+			} `json:"feeds"`
+		}
+		return client.Post(fmt.Sprintf(`query {
+			feeds(input: {userId: "%s", feedRefreshInputs: [{feedId: "%s", limit: 10, cursor: -1, direction: NEW}]}) {
+				id
+			}
+		}`, userId, feedId), &resp)
+	}
+	utils.TestUserSubscribeFeedAndValidate(t, viewerId, feedId, db, client)
+	require.Nil(t, queryFeed(viewerId))
+	require.NotNil(t, queryFeed(strangerId))
+	var subscribeResp struct {
+		Subscribe struct {
+			Id string `json:"id"`
+		} `json:"subscribe"`
+	}
+	require.NotNil(t, client.Post(fmt.Sprintf(`mutation {
+		subscribe(input: {userId: "%s", feedId: "%s"}) {
+			id
+		}
+	}`, strangerId, feedId), &subscribeResp))
+
+	// Editor can't delete the feed, only unsubscribe.
+	utils.TestDeleteFeedAndValidate(t, editorId, feedId, false, db, client)
+	require.Nil(t, addCollaborator(ownerId, editorId, model.FeedCollaboratorRoleOwner))
+	utils.TestDeleteFeedAndValidate(t, editorId, feedId, true, db, client)
+}
+
+func TestFeedStats(t *testing.T) {
+	db, _ := utils.CreateTempDB(t)
+	redis, _ := utils.GetRedisStatusStore()
+
+	client := PrepareTestForGraphQLAPIs(db, redis)
+
+	userId := utils.TestCreateUserAndValidate(t, "test_user_name", "stats_user_id", db, client)
+	sourceId := utils.TestCreateSourceAndValidate(t, userId, "test_source_for_stats", "test_domain", db, client)
+	subSourceId := utils.TestCreateSubSourceAndValidate(t, userId, "test_subsource_for_stats", "test_externalid", sourceId, false, db, client)
+	feedId, _ := utils.TestCreateFeedAndValidate(t, userId, "test_feed_for_stats", `{"a":1}`, []string{subSourceId}, model.VisibilityPrivate, db, client)
+	utils.TestCreatePostAndValidate(t, "test_title", "test_content", subSourceId, feedId, db, client)
+	utils.TestCreatePostAndValidate(t, "test_title", "test_content", subSourceId, feedId, db, client)
+	// Candidate filtered out by the feed.
+	utils.TestCreatePostAndValidate(t, "test_title", "test_content", subSourceId, "", db, client)
+
+	var resp struct {
+		FeedStats struct {
+			Volume []struct {
+				Count int `json:"count"`
+			} `json:"volume"`
+			SubSources []struct {
+				SubSourceId string `json:"subSourceId"`
+				Count       int    `json:"count"`
+			} `json:"subSources"`
+			PublishedCount int     `json:"publishedCount"`
+			CandidateCount int     `json:"candidateCount"`
+			MatchRate      float64 `json:"matchRate"`
+		} `json:"feedStats"`
+	}
+	now := time.Now()
+	client.MustPost(fmt.Sprintf(`query {
+		feedStats(input: {userId: "%s", feedId: "%s", from: "%s", to: "%s", bucket: DAY}) {
+			volume {
+				count
+			}
+			subSources {
+				subSourceId
+				count
+			}
+			publishedCount
+			candidateCount
+			matchRate
+		}
+	}`, userId, feedId, now.Add(-time.Hour).Format(time.RFC3339), now.Add(time.Hour).Format(time.RFC3339)), &resp)
+
+	require.Equal(t, 2, resp.FeedStats.PublishedCount)
+	require.Equal(t, 3, resp.FeedStats.CandidateCount)
+	require.InDelta(t, 2.0/3.0, resp.FeedStats.MatchRate, 0.0001)
+	require.Equal(t, 1, len(resp.FeedStats.SubSources))
+	require.Equal(t, subSourceId, resp.FeedStats.SubSources[0].SubSourceId)
+	require.Equal(t, 2, resp.FeedStats.SubSources[0].Count)
+	total := 0
+	for _, bucket := range resp.FeedStats.Volume {
+		total += bucket.Count
+	}
+	require.Equal(t, 2, total)
+}
+
 func TestDeleteFeed(t *testing.T) {
 	db, _ := utils.CreateTempDB(t)
 	redis, _ := utils.GetRedisStatusStore()
@@ -1,14 +1,21 @@
 package resolver
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"math"
+	"net"
+	"net/url"
 	"sort"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/prototext"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/Luismorlan/newsmux/model"
 	"github.com/Luismorlan/newsmux/protocol"
@@ -21,13 +28,86 @@ const (
 	defaultFeedsQueryCursor    = math.MaxInt32
 	defaultFeedsQueryDirection = model.FeedRefreshDirectionOld
 	maxRepublishDBBatches      = 10
+	syndicationTokenBytes      = 24
+
+	// Schedule of user created customized sources, crawling more frequently
+	// than min interval is not allowed to protect the crawled website.
+	defaultCustomizedCrawlerIntervalMs = 5 * 60 * 1000
+	minCustomizedCrawlerIntervalMs     = 60 * 1000
+)
+
+// Crawl urls are fetched from our network, hosts resolving to these
+// addresses are rejected so that users can't probe internal services.
+var internalNetworks = mustParseCIDRs(
+	"0.0.0.0/8",      // "this" network
+	"10.0.0.0/8",     // private
+	"100.64.0.0/10",  // carrier-grade NAT
+	"127.0.0.0/8",    // loopback
+	"169.254.0.0/16", // link-local, cloud metadata
+	"172.16.0.0/12",  // private
+	"192.168.0.0/16", // private
+	"::/128",         // unspecified
+	"::1/128",        // loopback
+	"fc00::/7",       // unique local
+	"fe80::/10",      // link-local
 )
 
+// lookupIP resolves crawl url hosts, replaced in tests.
+var lookupIP = net.LookupIP
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	res := []*net.IPNet{}
+	for _, cidr := range cidrs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		res = append(res, network)
+	}
+	return res
+}
+
+func isInternalIP(ip net.IP) bool {
+	for _, network := range internalNetworks {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateCrawlUrl checks the url is http(s) and its host doesn't resolve to
+// an internal address.
+func validateCrawlUrl(rawUrl string) error {
+	crawlUrl, err := url.Parse(rawUrl)
+	if err != nil || (crawlUrl.Scheme != "http" && crawlUrl.Scheme != "https") || crawlUrl.Hostname() == "" {
+		return fmt.Errorf("invalid crawl url %s", rawUrl)
+	}
+	host := crawlUrl.Hostname()
+	ips := []net.IP{net.ParseIP(host)}
+	if ips[0] == nil {
+		if ips, err = lookupIP(host); err != nil {
+			return fmt.Errorf("can't resolve host of crawl url %s", rawUrl)
+		}
+	}
+	for _, ip := range ips {
+		if isInternalIP(ip) {
+			return fmt.Errorf("crawl url %s is not allowed", rawUrl)
+		}
+	}
+	return nil
+}
+
 // Given a list of FeedRefreshInput, get posts for the requested feeds
 // Do it by iterating through feeds
 func getRefreshPosts(r *queryResolver, queries []*model.FeedRefreshInput, userId string) ([]*model.Feed, error) {
 	results := []*model.Feed{}
 
+	mutes, err := getUserMutes(r.DB, userId)
+	if err != nil {
+		return []*model.Feed{}, errors.Wrap(err, "failure when get user mutes")
+	}
+
 	//TODO: can be run in parallel
 	for idx := range queries {
 		query := queries[idx]
@@ -38,13 +118,13 @@ func getRefreshPosts(r *queryResolver, queries []*model.FeedRefreshInput, userId
 		// Prepare feed basic info
 		var feed model.Feed
 		queryResult := r.DB.Preload("SubSources").Where("id = ?", query.FeedID).First(&feed)
-		if queryResult.RowsAffected != 1 {
+		if queryResult.RowsAffected != 1 || !canReadFeed(r.DB, &feed, userId) {
 			return []*model.Feed{}, fmt.Errorf("invalid feed id %s", query.FeedID)
 		}
 		if err := sanitizeFeedRefreshInput(query, &feed); err != nil {
 			return []*model.Feed{}, errors.Wrap(err, fmt.Sprint("feed query invalid ", query))
 		}
-		if err := getFeedPostsOrRePublish(r.DB, r.RedisStatusStore, &feed, query, userId); err != nil {
+		if err := getFeedPostsOrRePublish(r.DB, r.RedisStatusStore, &feed, query, userId, mutes); err != nil {
 			return []*model.Feed{}, errors.Wrap(err, fmt.Sprint("failure when get posts for feed id ", feed.Id))
 		}
 		results = append(results, &feed)
@@ -53,7 +133,9 @@ func getRefreshPosts(r *queryResolver, queries []*model.FeedRefreshInput, userId
 	return results, nil
 }
 
-func getFeedPostsOrRePublish(db *gorm.DB, r *utils.RedisStatusStore, feed *model.Feed, query *model.FeedRefreshInput, userId string) error {
+// Muted posts are excluded at read time, the feed's published posts are not
+// changed since mutes are per user.
+func getFeedPostsOrRePublish(db *gorm.DB, r *utils.RedisStatusStore, feed *model.Feed, query *model.FeedRefreshInput, userId string, mutes []*model.UserMute) error {
 	var posts []*model.Post
 	// try to read published posts
 	if query.Direction == model.FeedRefreshDirectionNew {
@@ -71,6 +153,7 @@ func getFeedPostsOrRePublish(db *gorm.DB, r *utils.RedisStatusStore, feed *model
 			Joins("LEFT JOIN post_feed_publishes ON post_feed_publishes.post_id = posts.id").
 			Joins("LEFT JOIN feeds ON post_feed_publishes.feed_id = feeds.id").
 			Where("feed_id = ? AND posts.cursor > ?", feed.Id, query.Cursor).
+			Scopes(muteScope(mutes)).
 			Order("posts.cursor desc").
 			Limit(query.Limit).
 			Find(&posts)
@@ -90,6 +173,7 @@ func getFeedPostsOrRePublish(db *gorm.DB, r *utils.RedisStatusStore, feed *model
 			Joins("LEFT JOIN post_feed_publishes ON post_feed_publishes.post_id = posts.id").
 			Joins("LEFT JOIN feeds ON post_feed_publishes.feed_id = feeds.id").
 			Where("feed_id = ? AND posts.cursor < ?", feed.Id, query.Cursor).
+			Scopes(muteScope(mutes)).
 			Order("posts.cursor desc").
 			Limit(query.Limit).
 			Find(&posts)
@@ -109,6 +193,8 @@ func getFeedPostsOrRePublish(db *gorm.DB, r *utils.RedisStatusStore, feed *model
 		}
 	}
 
+	// Republished posts and shared posts are not filtered by muteScope.
+	feed.Posts = filterMutedPosts(feed.Posts, mutes)
 	sortPostsByCreationTime(feed.Posts)
 	// update feed read status from redis
 	postIds := []string{}
@@ -119,8 +205,19 @@ func getFeedPostsOrRePublish(db *gorm.DB, r *utils.RedisStatusStore, feed *model
 	if err != nil {
 		return errors.Wrap(err, "failure when get posts read status")
 	}
-	for idx := range feed.Posts {
-		feed.Posts[idx].IsRead = status[idx]
+	watermarks, err := r.GetFeedReadWatermarks(userId)
+	if err != nil {
+		return errors.Wrap(err, "failure when get feed read watermark")
+	}
+	watermark, hasWatermark := watermarks[feed.Id]
+	unreadExceptions := map[string]bool{}
+	if hasWatermark {
+		if unreadExceptions, err = r.GetUnreadExceptions(userId); err != nil {
+			return errors.Wrap(err, "failure when get unread exceptions")
+		}
+	}
+	for idx, post := range feed.Posts {
+		feed.Posts[idx].IsRead = isPostRead(post, status[idx], watermark, hasWatermark, unreadExceptions)
 	}
 	return nil
 }
@@ -251,6 +348,135 @@ func isClearPostsNeededForFeedsUpsert(feed *model.Feed, input *model.UpsertFeedI
 	return false, nil
 }
 
+// upsertFeedImpl creates or updates a feed and records the change as a new
+// version, restoredFromVersion is set when restoring a previous version.
+// Published posts are cleared if filter or subsources changed, which is
+// reported by postsCleared. Caller must authorize the user.
+func upsertFeedImpl(db *gorm.DB, input model.UpsertFeedInput, restoredFromVersion *int) (*model.Feed, bool, error) {
+	// Upsert a feed
+	// return feed with updated posts
+	var (
+		user           model.User
+		feed           model.Feed
+		needClearPosts = true
+	)
+
+	// get creator user
+	userID := input.UserID
+	queryResult := db.Where("id = ?", userID).First(&user)
+	if queryResult.RowsAffected != 1 {
+		return nil, false, errors.New("invalid user id")
+	}
+
+	if input.FeedID != nil {
+		// If it is update:
+		// 1. read from DB
+		queryResult := db.Where("id = ?", *input.FeedID).Preload("Creator").Preload("SubSources").Preload("Posts").First(&feed)
+		if queryResult.RowsAffected != 1 {
+			return nil, false, errors.New("invalid feed id")
+		}
+		if !hasFeedRole(db, &feed, userID, model.FeedCollaboratorRoleEditor) {
+			return nil, false, errors.New("user is not allowed to update this feed")
+		}
+
+		// 2. check if dropping posts is needed
+		var err error
+		needClearPosts, err = isClearPostsNeededForFeedsUpsert(&feed, &input)
+		if err != nil {
+			return nil, false, err
+		}
+
+		// Update feed object, creator is kept even if updated by a collaborator
+		feed.Name = input.Name
+		feed.FilterDataExpression = datatypes.JSON(input.FilterDataExpression)
+		feed.Visibility = input.Visibility
+	} else {
+		// If it is insert, create feed object
+		feed = model.Feed{
+			Id:                   uuid.New().String(),
+			Name:                 input.Name,
+			Creator:              user,
+			FilterDataExpression: datatypes.JSON(input.FilterDataExpression),
+			Visibility:           input.Visibility,
+		}
+	}
+
+	// One caveat on gorm: if we don't specify a createdAt
+	// gorm will automatically update its created time after Save is called
+	// even though DB is not udpated (this is a hell of debugging)
+
+	// Upsert DB
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// Update all columns, except primary keys and subscribers to new value, on conflict
+		queryResult = tx.Debug().Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "id"}},
+			UpdateAll: false,
+			DoUpdates: clause.AssignmentColumns([]string{"name", "updated_at", "filter_data_expression", "visibility"}),
+		}).Create(&feed)
+
+		if queryResult.RowsAffected != 1 {
+			return fmt.Errorf("can't upsert %s", queryResult.Error)
+		}
+
+		// Update subsources
+		var subSources []model.SubSource
+		tx.Where("id IN ?", input.SubSourceIds).Find(&subSources)
+		if e := tx.Model(&feed).Association("SubSources").Replace(subSources); e != nil {
+			return e
+		}
+
+		// Record the change so that it can be reviewed and restored
+		subSourceIds := []string{}
+		for _, subSource := range subSources {
+			subSourceIds = append(subSourceIds, subSource.Id)
+		}
+		action := model.FeedVersionActionUpdate
+		if input.FeedID == nil {
+			action = model.FeedVersionActionCreate
+		} else if restoredFromVersion != nil {
+			action = model.FeedVersionActionRestore
+		}
+		if err := recordFeedVersion(tx, &feed, subSourceIds, userID, action, restoredFromVersion); err != nil {
+			return err
+		}
+
+		// If user upsert the feed's visibility to be PRIVATE, delete all
+		// subscription other than the creator and collaborators.
+		if feed.Visibility == model.VisibilityPrivate {
+			if err := tx.Model(&model.UserFeedSubscription{}).
+				Where("user_id != ? AND feed_id = ?", feed.Creator.Id, feed.Id).
+				Where("user_id NOT IN (?)", tx.Model(&model.FeedCollaborator{}).
+					Select("user_id").Where("feed_id = ?", feed.Id)).
+				Delete(model.UserFeedSubscription{}).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	var updatedFeed model.Feed
+	db.First(&updatedFeed, "id = ?", feed.Id)
+	// db.Preload(clause.Associations).First(&updatedFeed, "id = ?", feed.Id)
+
+	// If no data expression or subsources changed, skip, otherwise clear the feed's posts
+	if !needClearPosts {
+		// get posts
+		Log.Info("update feed metadata without clear published posts")
+		return &updatedFeed, false, nil
+	}
+
+	// Clear the feed's posts
+	Log.Info("changed feed clear all posts published")
+	db.Where("feed_id = ?", updatedFeed.Id).Delete(&model.PostFeedPublish{})
+	updatedFeed.Posts = []*model.Post{}
+
+	return &updatedFeed, true, nil
+}
+
 func UpsertSubsourceImpl(db *gorm.DB, input model.UpsertSubSourceInput) (*model.SubSource, error) {
 	var subSource model.SubSource
 	queryResult := db.Preload("Feeds").Preload("Feeds.SubscribedChannels").
@@ -323,6 +549,12 @@ func UpsertSubsourceImpl(db *gorm.DB, input model.UpsertSubSourceInput) (*model.
 // For Customized SubSource
 // Transform user provided form into CustomizedCrawlerParams in panoptic.proto
 func ConstructCustomizedCrawlerParams(input model.CustomizedCrawlerParams) (*protocol.CustomizedCrawlerParams, error) {
+	if err := validateCrawlUrl(input.CrawlURL); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(input.BaseSelector) == "" {
+		return nil, errors.New("base selector can't be empty")
+	}
 	customizedCrawlerParams := &protocol.CustomizedCrawlerParams{
 		CrawlUrl:                   input.CrawlURL,
 		BaseSelector:               input.BaseSelector,
@@ -337,3 +569,66 @@ func ConstructCustomizedCrawlerParams(input model.CustomizedCrawlerParams) (*pro
 	}
 	return customizedCrawlerParams, nil
 }
+
+// For Customized Source
+// Transform user provided form into a source level PanopticConfig, which is
+// scheduled by panoptic together with configs from Github. Config name is
+// suffixed with source id, since a duplicate name can't be scheduled.
+func ConstructCustomizedCrawlerPanopticConfig(form model.CustomizedCrawlerPanopticConfigForm, source *model.Source) (*protocol.PanopticConfig, error) {
+	if form.CustomizedCrawlerParams == nil {
+		return nil, errors.New("customized crawler params is required")
+	}
+	params, err := ConstructCustomizedCrawlerParams(*form.CustomizedCrawlerParams)
+	if err != nil {
+		return nil, err
+	}
+
+	name := source.Name
+	if form.Name != nil && *form.Name != "" {
+		name = *form.Name
+	}
+	startImmediately := true
+	if form.StartImmediately != nil {
+		startImmediately = *form.StartImmediately
+	}
+	everyMilliseconds := int64(defaultCustomizedCrawlerIntervalMs)
+	if form.ScheduleEveryMilliseconds != nil {
+		everyMilliseconds = int64(*form.ScheduleEveryMilliseconds)
+	}
+	if everyMilliseconds < minCustomizedCrawlerIntervalMs {
+		return nil, fmt.Errorf("schedule interval must be at least %d milliseconds", minCustomizedCrawlerIntervalMs)
+	}
+
+	return &protocol.PanopticConfig{
+		Name:            fmt.Sprintf("%s_%s", name, source.Id),
+		DataCollectorId: protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SOURCE,
+		TaskParams: &protocol.TaskParams{
+			SourceId: source.Id,
+			SubSources: []*protocol.PanopticSubSource{
+				{
+					Name: DefaultSubSourceName,
+					Type: protocol.PanopticSubSource_FLASHNEWS,
+				},
+			},
+			Params: &protocol.TaskParams_CustomizedSourceCrawlerTaskParams{
+				CustomizedSourceCrawlerTaskParams: params,
+			},
+		},
+		TaskSchedule: &protocol.TaskSchedule{
+			StartImmediatly: startImmediately,
+			Schedule: &protocol.TaskSchedule_Routinely{
+				Routinely: &protocol.Routinely{EveryMilliseconds: everyMilliseconds},
+			},
+		},
+	}, nil
+}
+
+// newSyndicationToken returns a random hex token used to read a PRIVATE feed
+// from RSS readers, where we can't perform Cognito login.
+func newSyndicationToken() (string, error) {
+	b := make([]byte, syndicationTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,98 @@
+package resolver
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/protocol"
+)
+
+// fakeLookupIP resolves hosts from the given map without network.
+func fakeLookupIP(t *testing.T, hosts map[string]string) {
+	lookupIP = func(host string) ([]net.IP, error) {
+		if ip, ok := hosts[host]; ok {
+			return []net.IP{net.ParseIP(ip)}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	t.Cleanup(func() { lookupIP = net.LookupIP })
+}
+
+func TestValidateCrawlUrl(t *testing.T) {
+	fakeLookupIP(t, map[string]string{
+		"example.com":          "93.184.216.34",
+		"localhost":            "127.0.0.1",
+		"internal.example.com": "10.1.2.3",
+	})
+
+	assert.Nil(t, validateCrawlUrl("https://example.com/news"))
+	assert.Nil(t, validateCrawlUrl("http://93.184.216.34:8080/"))
+	for _, rawUrl := range []string{
+		"http://169.254.169.254/latest/meta-data/",
+		"http://localhost:8080/",
+		"http://127.0.0.1/",
+		"http://[::1]/",
+		"http://192.168.1.1/",
+		"http://172.16.0.1/",
+		"http://internal.example.com/",
+		"http://unknown.example.com/",
+	} {
+		assert.NotNil(t, validateCrawlUrl(rawUrl), rawUrl)
+	}
+}
+
+func TestConstructCustomizedCrawlerParams(t *testing.T) {
+	fakeLookupIP(t, map[string]string{"example.com": "93.184.216.34"})
+
+	_, err := ConstructCustomizedCrawlerParams(model.CustomizedCrawlerParams{CrawlURL: "https://example.com", BaseSelector: ".item"})
+	assert.Nil(t, err)
+	_, err = ConstructCustomizedCrawlerParams(model.CustomizedCrawlerParams{CrawlURL: "example.com", BaseSelector: ".item"})
+	assert.NotNil(t, err)
+	_, err = ConstructCustomizedCrawlerParams(model.CustomizedCrawlerParams{CrawlURL: "ftp://example.com", BaseSelector: ".item"})
+	assert.NotNil(t, err)
+	_, err = ConstructCustomizedCrawlerParams(model.CustomizedCrawlerParams{CrawlURL: "https://example.com", BaseSelector: " "})
+	assert.NotNil(t, err)
+}
+
+func TestConstructCustomizedCrawlerPanopticConfig(t *testing.T) {
+	fakeLookupIP(t, map[string]string{"example.com": "93.184.216.34"})
+	source := &model.Source{Id: "source_id", Name: "source"}
+	params := &model.CustomizedCrawlerParams{CrawlURL: "https://example.com", BaseSelector: ".item"}
+
+	config, err := ConstructCustomizedCrawlerPanopticConfig(model.CustomizedCrawlerPanopticConfigForm{
+		CustomizedCrawlerParams: params,
+	}, source)
+	require.Nil(t, err)
+	assert.Equal(t, "source_source_id", config.Name)
+	assert.Equal(t, protocol.PanopticTask_COLLECTOR_USER_CUSTOMIZED_SOURCE, config.DataCollectorId)
+	assert.Equal(t, "source_id", config.TaskParams.SourceId)
+	assert.Equal(t, ".item", config.TaskParams.GetCustomizedSourceCrawlerTaskParams().BaseSelector)
+	assert.True(t, config.TaskSchedule.StartImmediatly)
+	assert.Equal(t, int64(defaultCustomizedCrawlerIntervalMs), config.TaskSchedule.GetRoutinely().EveryMilliseconds)
+
+	name := "config_name"
+	startImmediately := false
+	every := 10 * 60 * 1000
+	config, err = ConstructCustomizedCrawlerPanopticConfig(model.CustomizedCrawlerPanopticConfigForm{
+		Name:                      &name,
+		StartImmediately:          &startImmediately,
+		ScheduleEveryMilliseconds: &every,
+		CustomizedCrawlerParams:   params,
+	}, source)
+	require.Nil(t, err)
+	assert.Equal(t, "config_name_source_id", config.Name)
+	assert.False(t, config.TaskSchedule.StartImmediatly)
+	assert.Equal(t, int64(every), config.TaskSchedule.GetRoutinely().EveryMilliseconds)
+
+	tooFrequent := 1000
+	_, err = ConstructCustomizedCrawlerPanopticConfig(model.CustomizedCrawlerPanopticConfigForm{
+		ScheduleEveryMilliseconds: &tooFrequent,
+		CustomizedCrawlerParams:   params,
+	}, source)
+	assert.NotNil(t, err)
+}
@@ -12,14 +12,19 @@ import (
 	"github.com/Luismorlan/newsmux/collector"
 	"github.com/Luismorlan/newsmux/model"
 	"github.com/Luismorlan/newsmux/server/graph/generated"
+	"github.com/Luismorlan/newsmux/server/middlewares"
 	Logger "github.com/Luismorlan/newsmux/utils/log"
 	"github.com/google/uuid"
-	"gorm.io/datatypes"
+	"google.golang.org/protobuf/encoding/prototext"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
 func (r *mutationResolver) CreateUser(ctx context.Context, input model.NewUserInput) (*model.User, error) {
+	if err := authorizeUser(ctx, input.ID); err != nil {
+		return nil, err
+	}
+
 	var user model.User
 	res := r.DB.Model(&model.User{}).Where("id = ?", input.ID).First(&user)
 	if res.RowsAffected == 0 {
@@ -42,112 +47,19 @@ func (r *mutationResolver) CreateUser(ctx context.Context, input model.NewUserIn
 }
 
 func (r *mutationResolver) UpsertFeed(ctx context.Context, input model.UpsertFeedInput) (*model.Feed, error) {
-	// Upsert a feed
-	// return feed with updated posts
-	var (
-		user           model.User
-		feed           model.Feed
-		needClearPosts = true
-	)
-
-	// get creator user
-	userID := input.UserID
-	queryResult := r.DB.Where("id = ?", userID).First(&user)
-	if queryResult.RowsAffected != 1 {
-		return nil, errors.New("invalid user id")
-	}
-
-	if input.FeedID != nil {
-		// If it is update:
-		// 1. read from DB
-		queryResult := r.DB.Where("id = ?", *input.FeedID).Preload("SubSources").Preload("Posts").First(&feed)
-		if queryResult.RowsAffected != 1 {
-			return nil, errors.New("invalid feed id")
-		}
-
-		// 2. check if dropping posts is needed
-		var err error
-		needClearPosts, err = isClearPostsNeededForFeedsUpsert(&feed, &input)
-		if err != nil {
-			return nil, err
-		}
-
-		// Update feed object
-		feed.Name = input.Name
-		feed.Creator = user
-		feed.FilterDataExpression = datatypes.JSON(input.FilterDataExpression)
-		feed.Visibility = input.Visibility
-	} else {
-		// If it is insert, create feed object
-		feed = model.Feed{
-			Id:                   uuid.New().String(),
-			Name:                 input.Name,
-			Creator:              user,
-			FilterDataExpression: datatypes.JSON(input.FilterDataExpression),
-			Visibility:           input.Visibility,
-		}
-	}
-
-	// One caveat on gorm: if we don't specify a createdAt
-	// gorm will automatically update its created time after Save is called
-	// even though DB is not udpated (this is a hell of debugging)
-
-	// Upsert DB
-	err := r.DB.Transaction(func(tx *gorm.DB) error {
-		// Update all columns, except primary keys and subscribers to new value, on conflict
-		queryResult = tx.Debug().Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "id"}},
-			UpdateAll: false,
-			DoUpdates: clause.AssignmentColumns([]string{"name", "updated_at", "creator_id", "filter_data_expression", "visibility"}),
-		}).Create(&feed)
-
-		if queryResult.RowsAffected != 1 {
-			return fmt.Errorf("can't upsert %s", queryResult.Error)
-		}
-
-		// Update subsources
-		var subSources []model.SubSource
-		tx.Where("id IN ?", input.SubSourceIds).Find(&subSources)
-		if e := tx.Model(&feed).Association("SubSources").Replace(subSources); e != nil {
-			return e
-		}
-
-		// If user upsert the feed's visibility to be PRIVATE, delete all
-		// subscription other than the user himself.
-		if feed.Visibility == model.VisibilityPrivate {
-			if err := tx.Model(&model.UserFeedSubscription{}).
-				Where("user_id != ? AND feed_id = ?", feed.Creator.Id, feed.Id).
-				Delete(model.UserFeedSubscription{}).Error; err != nil {
-				return err
-			}
-		}
-
-		return nil
-	})
-	if err != nil {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
 		return nil, err
 	}
 
-	var updatedFeed model.Feed
-	r.DB.First(&updatedFeed, "id = ?", feed.Id)
-	// r.DB.Preload(clause.Associations).First(&updatedFeed, "id = ?", feed.Id)
-
-	// If no data expression or subsources changed, skip, otherwise clear the feed's posts
-	if !needClearPosts {
-		// get posts
-		Logger.Log.Info("update feed metadata without clear published posts")
-		return &updatedFeed, nil
-	}
-
-	// Clear the feed's posts
-	Logger.Log.Info("changed feed clear all posts published")
-	r.DB.Where("feed_id = ?", updatedFeed.Id).Delete(&model.PostFeedPublish{})
-	updatedFeed.Posts = []*model.Post{}
-
-	return &updatedFeed, nil
+	feed, _, err := upsertFeedImpl(r.DB, input, nil)
+	return feed, err
 }
 
 func (r *mutationResolver) DeleteFeed(ctx context.Context, input model.DeleteFeedInput) (*model.Feed, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
 	userId := input.UserID
 	feedId := input.FeedID
 
@@ -164,7 +76,7 @@ func (r *mutationResolver) DeleteFeed(ctx context.Context, input model.DeleteFee
 	// Check ownership, if the deletion operation is not initiated from the Feed
 	// owner, this is just unsubscribe. This is used in Feed sharing, where the
 	// non-owner unsubscribes a feed.
-	if feed.CreatorID != userId {
+	if !hasFeedRole(r.DB, &feed, userId, model.FeedCollaboratorRoleOwner) {
 		sub := model.UserFeedSubscription{}
 		if err := r.DB.Model(&model.UserFeedSubscription{}).
 			Where("user_id = ? AND feed_id = ?", userId, feedId).
@@ -188,6 +100,10 @@ func (r *mutationResolver) DeleteFeed(ctx context.Context, input model.DeleteFee
 }
 
 func (r *mutationResolver) CreatePost(ctx context.Context, input model.NewPostInput) (*model.Post, error) {
+	if _, err := authenticate(ctx); err != nil {
+		return nil, err
+	}
+
 	var (
 		subSource      model.SubSource
 		sharedFromPost *model.Post
@@ -243,6 +159,10 @@ func (r *mutationResolver) CreatePost(ctx context.Context, input model.NewPostIn
 }
 
 func (r *mutationResolver) Subscribe(ctx context.Context, input model.SubscribeInput) (*model.User, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
 	userId := input.UserID
 	feedId := input.FeedID
 
@@ -264,6 +184,10 @@ func (r *mutationResolver) Subscribe(ctx context.Context, input model.SubscribeI
 	if result.Error != nil {
 		return nil, result.Error
 	}
+	// Do not leak private feeds of other users.
+	if !canReadFeed(r.DB, &feed, userId) {
+		return nil, fmt.Errorf("no valid feed found %s", feedId)
+	}
 
 	count := r.DB.Model(&user).Association("SubscribedFeeds").Count()
 
@@ -294,6 +218,10 @@ func (r *mutationResolver) Subscribe(ctx context.Context, input model.SubscribeI
 }
 
 func (r *mutationResolver) CreateSource(ctx context.Context, input model.NewSourceInput) (*model.Source, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
 	// get creator user
 	var user model.User
 	queryResult := r.DB.Where("id = ?", input.UserID).First(&user)
@@ -309,8 +237,25 @@ func (r *mutationResolver) CreateSource(ctx context.Context, input model.NewSour
 		Creator:   user,
 	}
 
+	// Source level customized crawler is stored with the source, and picked up
+	// by panoptic scheduler on its next config poll.
+	if input.CustomizedCrawlerPanopticConfigForm != nil {
+		config, err := ConstructCustomizedCrawlerPanopticConfig(*input.CustomizedCrawlerPanopticConfigForm, &source)
+		if err != nil {
+			return nil, err
+		}
+		bytes, err := prototext.Marshal(config)
+		if err != nil {
+			return nil, err
+		}
+		str := string(bytes)
+		source.CustomizedCrawlerPanopticConfig = &str
+	}
+
 	err := r.DB.Transaction(func(tx *gorm.DB) error {
-		tx.Create(&source)
+		if err := tx.Create(&source).Error; err != nil {
+			return err
+		}
 		// Create default sub source, this subsource have no creator, no external id
 
 		UpsertSubsourceImpl(tx, model.UpsertSubSourceInput{
@@ -325,18 +270,31 @@ func (r *mutationResolver) CreateSource(ctx context.Context, input model.NewSour
 }
 
 func (r *mutationResolver) UpsertSubSource(ctx context.Context, input model.UpsertSubSourceInput) (*model.SubSource, error) {
+	if _, err := authenticate(ctx); err != nil {
+		return nil, err
+	}
 	return UpsertSubsourceImpl(r.DB, input)
 }
 
 func (r *mutationResolver) AddWeiboSubSource(ctx context.Context, input model.AddWeiboSubSourceInput) (*model.SubSource, error) {
+	if _, err := authenticate(ctx); err != nil {
+		return nil, err
+	}
 	return AddWeiboSubsourceImp(r.DB, ctx, input)
 }
 
 func (r *mutationResolver) AddSubSource(ctx context.Context, input model.AddSubSourceInput) (*model.SubSource, error) {
+	if _, err := authenticate(ctx); err != nil {
+		return nil, err
+	}
 	return AddSubSourceImp(r.DB, ctx, input)
 }
 
 func (r *mutationResolver) DeleteSubSource(ctx context.Context, input *model.DeleteSubSourceInput) (*model.SubSource, error) {
+	if _, err := authenticate(ctx); err != nil {
+		return nil, err
+	}
+
 	var subSource model.SubSource
 	queryResult := r.DB.Where("id = ?", input.SubsourceID).First(&subSource)
 	if queryResult.RowsAffected == 0 {
@@ -348,6 +306,10 @@ func (r *mutationResolver) DeleteSubSource(ctx context.Context, input *model.Del
 }
 
 func (r *mutationResolver) SyncUp(ctx context.Context, input *model.SeedStateInput) (*model.SeedState, error) {
+	if err := authorizeUser(ctx, input.UserSeedState.ID); err != nil {
+		return nil, err
+	}
+
 	if err := r.DB.Transaction(syncUpTransaction(input)); err != nil {
 		return nil, err
 	}
@@ -368,6 +330,10 @@ func (r *mutationResolver) SyncUp(ctx context.Context, input *model.SeedStateInp
 }
 
 func (r *mutationResolver) SetItemsReadStatus(ctx context.Context, input model.SetItemsReadStatusInput) (bool, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return false, err
+	}
+
 	err := r.RedisStatusStore.SetItemsReadStatus(input.ItemNodeIds, input.UserID, input.Read)
 	if err != nil {
 		return false, err
@@ -391,6 +357,380 @@ func (r *mutationResolver) SetItemsReadStatus(ctx context.Context, input model.S
 	return true, nil
 }
 
+func (r *mutationResolver) RotateFeedSyndicationToken(ctx context.Context, input model.RotateFeedSyndicationTokenInput) (string, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return "", err
+	}
+
+	var feed model.Feed
+	result := r.DB.First(&feed, "id = ?", input.FeedID)
+	if result.RowsAffected != 1 {
+		return "", errors.New("no valid feed found")
+	}
+	if feed.CreatorID != input.UserID {
+		return "", errors.New("only feed creator can rotate syndication token")
+	}
+
+	token, err := newSyndicationToken()
+	if err != nil {
+		return "", err
+	}
+	// Use UpdateColumn so that feed's updated_at is untouched, otherwise all
+	// clients will consider the feed changed and drop their cursors.
+	if err := r.DB.Model(&feed).UpdateColumn("syndication_token", token).Error; err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (r *mutationResolver) ImportFeeds(ctx context.Context, input model.ImportFeedsInput) (*model.ImportFeedsResult, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+	return importFeedsImpl(r, ctx, input)
+}
+
+func (r *mutationResolver) CloneFeed(ctx context.Context, input model.CloneFeedInput) (*model.Feed, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	var (
+		user     model.User
+		original model.Feed
+	)
+	if r.DB.First(&user, "id = ?", input.UserID).RowsAffected != 1 {
+		return nil, fmt.Errorf("no valid user found %s", input.UserID)
+	}
+	if r.DB.Preload("SubSources").First(&original, "id = ?", input.FeedID).RowsAffected != 1 {
+		return nil, errors.New("no valid feed found")
+	}
+	// Do not leak private feeds of other users.
+	if !canReadFeed(r.DB, &original, input.UserID) {
+		return nil, errors.New("no valid feed found")
+	}
+
+	clone := model.Feed{
+		Id:                   uuid.New().String(),
+		Name:                 input.Name,
+		Creator:              user,
+		FilterDataExpression: original.FilterDataExpression,
+		Visibility:           model.VisibilityPrivate,
+		ClonedFromFeedID:     &original.Id,
+	}
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&clone).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&clone).Association("SubSources").Replace(original.SubSources); err != nil {
+			return err
+		}
+		subSourceIds := []string{}
+		for _, subSource := range original.SubSources {
+			subSourceIds = append(subSourceIds, subSource.Id)
+		}
+		if err := recordFeedVersion(tx, &clone, subSourceIds, input.UserID, model.FeedVersionActionCreate, nil); err != nil {
+			return err
+		}
+		// Copy published posts so that the clone isn't empty until the next
+		// crawl. Posts are the same entities, only the publish relation is
+		// duplicated.
+		if input.CopyPosts != nil && *input.CopyPosts {
+			if err := tx.Exec(`INSERT INTO post_feed_publishes (post_id, feed_id, created_at)
+				SELECT post_id, ?, created_at FROM post_feed_publishes WHERE feed_id = ?`,
+				clone.Id, original.Id).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := r.Subscribe(ctx, model.SubscribeInput{UserID: input.UserID, FeedID: clone.Id}); err != nil {
+		return nil, err
+	}
+
+	// Subscribing the clone updates seed state.
+	go func() {
+		r.SignalChans.PushSignalToUser(&model.Signal{
+			SignalType: model.SignalTypeSeedState}, input.UserID)
+	}()
+
+	var res model.Feed
+	r.DB.Preload("SubSources").First(&res, "id = ?", clone.Id)
+	return &res, nil
+}
+
+func (r *mutationResolver) AddFeedCollaborator(ctx context.Context, input model.AddFeedCollaboratorInput) (*model.FeedCollaborator, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	var (
+		feed         model.Feed
+		collaborator model.User
+	)
+	if r.DB.First(&feed, "id = ?", input.FeedID).RowsAffected != 1 {
+		return nil, errors.New("no valid feed found")
+	}
+	if !hasFeedRole(r.DB, &feed, input.UserID, model.FeedCollaboratorRoleOwner) {
+		return nil, errors.New("only feed owner can manage collaborators")
+	}
+	if r.DB.First(&collaborator, "id = ?", input.CollaboratorID).RowsAffected != 1 {
+		return nil, fmt.Errorf("no valid user found %s", input.CollaboratorID)
+	}
+	if feed.CreatorID == input.CollaboratorID {
+		return nil, errors.New("feed creator is always the owner")
+	}
+
+	res := model.FeedCollaborator{
+		FeedID: feed.Id,
+		UserID: collaborator.Id,
+		User:   collaborator,
+		Role:   input.Role,
+	}
+	if err := r.DB.Omit("Feed", "User").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "feed_id"}, {Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role"}),
+	}).Create(&res).Error; err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (r *mutationResolver) RemoveFeedCollaborator(ctx context.Context, input model.RemoveFeedCollaboratorInput) (*model.Feed, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	var feed model.Feed
+	if r.DB.First(&feed, "id = ?", input.FeedID).RowsAffected != 1 {
+		return nil, errors.New("no valid feed found")
+	}
+	// Collaborators can always leave a feed by themselves.
+	if input.UserID != input.CollaboratorID &&
+		!hasFeedRole(r.DB, &feed, input.UserID, model.FeedCollaboratorRoleOwner) {
+		return nil, errors.New("only feed owner can manage collaborators")
+	}
+	if err := r.DB.
+		Where("feed_id = ? AND user_id = ?", input.FeedID, input.CollaboratorID).
+		Delete(&model.FeedCollaborator{}).Error; err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+func (r *mutationResolver) MarkFeedRead(ctx context.Context, input model.MarkFeedReadInput) (bool, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return false, err
+	}
+
+	var feed model.Feed
+	if r.DB.First(&feed, "id = ?", input.FeedID).RowsAffected != 1 {
+		return false, errors.New("no valid feed found")
+	}
+	// Do not leak post ids of private feeds of other users.
+	if !canReadFeed(r.DB, &feed, input.UserID) {
+		return false, errors.New("no valid feed found")
+	}
+
+	unreadExceptions, err := r.RedisStatusStore.GetUnreadExceptions(input.UserID)
+	if err != nil {
+		return false, err
+	}
+	clearedExceptions, err := getUnreadExceptionsUpToCursor(r.DB, feed.Id, input.UpToCursor, unreadExceptions)
+	if err != nil {
+		return false, err
+	}
+	if err := r.RedisStatusStore.AdvanceFeedReadWatermark(input.UserID, feed.Id, input.UpToCursor, clearedExceptions); err != nil {
+		return false, err
+	}
+
+	postIds, err := getPostIdsUpToCursor(r.DB, feed.Id, input.UpToCursor, markFeedReadSignalLimit)
+	if err != nil {
+		return false, err
+	}
+
+	if len(postIds) == 0 {
+		return true, nil
+	}
+	go func() {
+		payload := ReadStatusPayload{
+			read:        true,
+			itemNodeIds: postIds,
+			delimiter:   "__",
+			itemType:    model.ItemTypePost,
+		}
+		ser, _ := payload.Marshal()
+		err := r.SignalChans.PushSignalToUser(&model.Signal{
+			SignalType:    model.SignalTypeSetItemsReadStatus,
+			SignalPayload: ser,
+		}, input.UserID)
+		if err != nil {
+			Logger.Log.Errorf("failed to push read status signal to user %s: %v", input.UserID, err)
+		}
+	}()
+	return true, nil
+}
+
+func (r *mutationResolver) Mute(ctx context.Context, input model.MuteInput) (*model.UserMute, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	if input.Target == "" {
+		return nil, errors.New("mute target can't be empty")
+	}
+	var user model.User
+	if r.DB.First(&user, "id = ?", input.UserID).RowsAffected != 1 {
+		return nil, fmt.Errorf("no valid user found %s", input.UserID)
+	}
+
+	var mute model.UserMute
+	if r.DB.Where("user_id = ? AND type = ? AND target = ?", input.UserID, input.Type, input.Target).
+		First(&mute).RowsAffected == 1 {
+		return &mute, nil
+	}
+	mute = model.UserMute{
+		Id:     uuid.New().String(),
+		UserID: input.UserID,
+		Type:   input.Type,
+		Target: input.Target,
+	}
+	if err := r.DB.Omit("User").Create(&mute).Error; err != nil {
+		return nil, err
+	}
+
+	// Mutes are part of seed state, all devices should refetch.
+	go func() {
+		r.SignalChans.PushSignalToUser(&model.Signal{
+			SignalType: model.SignalTypeSeedState}, input.UserID)
+	}()
+
+	return &mute, nil
+}
+
+func (r *mutationResolver) Unmute(ctx context.Context, input model.UnmuteInput) (*model.UserMute, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	var mute model.UserMute
+	if r.DB.Where("id = ? AND user_id = ?", input.MuteID, input.UserID).
+		First(&mute).RowsAffected != 1 {
+		return nil, errors.New("no valid mute found")
+	}
+	if err := r.DB.Delete(&mute).Error; err != nil {
+		return nil, err
+	}
+
+	// Mutes are part of seed state, all devices should refetch.
+	go func() {
+		r.SignalChans.PushSignalToUser(&model.Signal{
+			SignalType: model.SignalTypeSeedState}, input.UserID)
+	}()
+
+	return &mute, nil
+}
+
+func (r *mutationResolver) RestoreFeedVersion(ctx context.Context, input model.RestoreFeedVersionInput) (*model.Feed, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	var version model.FeedVersion
+	if r.DB.Where("feed_id = ? AND version = ?", input.FeedID, input.Version).
+		First(&version).RowsAffected != 1 {
+		return nil, errors.New("no valid feed version found")
+	}
+
+	// Restoring goes through upsert, so that permission check, subscription
+	// cleanup and version recording are the same as an edit.
+	feed, postsCleared, err := upsertFeedImpl(r.DB, model.UpsertFeedInput{
+		UserID:               input.UserID,
+		FeedID:               &input.FeedID,
+		Name:                 version.Name,
+		FilterDataExpression: version.FilterDataExpression.String(),
+		SubSourceIds:         version.SubSourceIds,
+		Visibility:           version.Visibility,
+	}, &version.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	var restored model.Feed
+	if err := r.DB.Preload("SubSources").First(&restored, "id = ?", feed.Id).Error; err != nil {
+		return nil, err
+	}
+	// Rebuild posts right away instead of waiting for the next feeds query.
+	if postsCleared {
+		rePublishPostsFromCursor(r.DB, &restored, feedRefreshLimit, defaultFeedsQueryCursor)
+	}
+	return &restored, nil
+}
+
+func (r *mutationResolver) CreatePersonalAccessToken(ctx context.Context, input model.CreatePersonalAccessTokenInput) (*model.CreatePersonalAccessTokenResult, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	scopes, err := toScopes(input.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	if input.ExpiresAt != nil && input.ExpiresAt.Before(time.Now()) {
+		return nil, errors.New("expiresAt must be in the future")
+	}
+	var user model.User
+	if r.DB.First(&user, "id = ?", input.UserID).RowsAffected != 1 {
+		return nil, fmt.Errorf("no valid user found %s", input.UserID)
+	}
+
+	token, hash, err := middlewares.NewPersonalAccessToken()
+	if err != nil {
+		return nil, err
+	}
+	pat := model.PersonalAccessToken{
+		Id:        uuid.New().String(),
+		UserID:    input.UserID,
+		Name:      input.Name,
+		TokenHash: hash,
+		Scopes:    scopes,
+		ExpiresAt: input.ExpiresAt,
+	}
+	if err := r.DB.Omit("User").Create(&pat).Error; err != nil {
+		return nil, err
+	}
+
+	return &model.CreatePersonalAccessTokenResult{
+		Token:               token,
+		PersonalAccessToken: &pat,
+	}, nil
+}
+
+func (r *mutationResolver) RevokePersonalAccessToken(ctx context.Context, input model.RevokePersonalAccessTokenInput) (*model.PersonalAccessToken, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	var pat model.PersonalAccessToken
+	if r.DB.Where("id = ? AND user_id = ?", input.TokenID, input.UserID).
+		First(&pat).RowsAffected != 1 {
+		return nil, errors.New("no valid personal access token found")
+	}
+	if pat.RevokedAt != nil {
+		return &pat, nil
+	}
+	now := time.Now()
+	if err := r.DB.Model(&pat).Update("revoked_at", now).Error; err != nil {
+		return nil, err
+	}
+	pat.RevokedAt = &now
+	return &pat, nil
+}
+
 func (r *queryResolver) AllVisibleFeeds(ctx context.Context) ([]*model.Feed, error) {
 	var feeds []*model.Feed
 
@@ -424,7 +764,7 @@ func (r *queryResolver) Post(ctx context.Context, input *model.PostInput) (*mode
 
 func (r *queryResolver) Posts(ctx context.Context) ([]*model.Post, error) {
 	var posts []*model.Post
-	result := r.DB.Preload(clause.Associations).Find(&posts)
+	result := r.DB.Preload(clause.Associations).Order("created_at desc").Limit(postsQueryLimit).Find(&posts)
 	return posts, result.Error
 }
 
@@ -435,6 +775,10 @@ func (r *queryResolver) Users(ctx context.Context) ([]*model.User, error) {
 }
 
 func (r *queryResolver) UserState(ctx context.Context, input model.UserStateInput) (*model.UserState, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
 	var user model.User
 	res := r.DB.Model(&model.User{}).Where("id=?", input.UserID).First(&user)
 	if res.RowsAffected != 1 {
@@ -453,10 +797,22 @@ func (r *queryResolver) UserState(ctx context.Context, input model.UserStateInpu
 		user.SubscribedFeeds = append(user.SubscribedFeeds, &feeds[idx])
 	}
 
-	return &model.UserState{User: &user}, nil
+	editableFeedIds, err := getEditableFeedIds(r.DB, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UserState{User: &user, EditableFeedIds: editableFeedIds}, nil
 }
 
 func (r *queryResolver) Feeds(ctx context.Context, input *model.FeedsGetPostsInput) ([]*model.Feed, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+	if err := validateFeedsGetPostsInput(ctx, input); err != nil {
+		return nil, err
+	}
+
 	feedRefreshInputs := input.FeedRefreshInputs
 	if len(feedRefreshInputs) == 0 {
 		feeds, err := getUserSubscriptions(r, input.UserID)
@@ -497,10 +853,77 @@ func (r *queryResolver) Sources(ctx context.Context, input *model.SourcesInput)
 }
 
 func (r *queryResolver) TryCustomizedCrawler(ctx context.Context, input *model.CustomizedCrawlerParams) ([]*model.CustomizedCrawlerTestResponse, error) {
+	identity, err := authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := allowFieldRequest(ctx, r.RedisStatusStore, "tryCustomizedCrawler", identity.Subject, tryCustomizedCrawlerRateLimit); err != nil {
+		return nil, err
+	}
+	if err := validateCrawlUrl(input.CrawlURL); err != nil {
+		return nil, err
+	}
 	return collector.TryCustomizedCrawler(input)
 }
 
+func (r *queryResolver) ExportFeeds(ctx context.Context, input model.ExportFeedsInput) (string, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return "", err
+	}
+	return exportFeedsImpl(r.DB, input.UserID, input.Format)
+}
+
+func (r *queryResolver) FeedStats(ctx context.Context, input model.FeedStatsInput) (*model.FeedStats, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	var feed model.Feed
+	if r.DB.First(&feed, "id = ?", input.FeedID).RowsAffected != 1 {
+		return nil, errors.New("no valid feed found")
+	}
+	if !canReadFeed(r.DB, &feed, input.UserID) {
+		return nil, errors.New("no valid feed found")
+	}
+
+	return getFeedStats(r.DB, r.RedisStatusStore, &feed, input)
+}
+
+func (r *queryResolver) FeedHistory(ctx context.Context, input model.FeedHistoryInput) ([]*model.FeedVersion, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	var feed model.Feed
+	if r.DB.First(&feed, "id = ?", input.FeedID).RowsAffected != 1 {
+		return nil, errors.New("no valid feed found")
+	}
+	if !canReadFeed(r.DB, &feed, input.UserID) {
+		return nil, errors.New("no valid feed found")
+	}
+
+	limit := defaultFeedHistoryLimit
+	if input.Limit != nil {
+		limit = *input.Limit
+	}
+	return getFeedHistory(r.DB, feed.Id, limit)
+}
+
+func (r *queryResolver) PersonalAccessTokens(ctx context.Context, input model.PersonalAccessTokensInput) ([]*model.PersonalAccessToken, error) {
+	if err := authorizeUser(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	pats := []*model.PersonalAccessToken{}
+	err := r.DB.Where("user_id = ?", input.UserID).Order("created_at").Find(&pats).Error
+	return pats, err
+}
+
 func (r *subscriptionResolver) Signal(ctx context.Context, userID string) (<-chan *model.Signal, error) {
+	if err := authorizeUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
 	ch, chId := r.SignalChans.AddNewConnection(ctx, userID)
 	// Initially, user by default will receive SeedState signal.
 	r.SignalChans.PushSignalToSingleChannelForUser(
@@ -54,6 +54,8 @@ func updateUserSeedState(tx *gorm.DB, input *model.SeedStateInput) error {
 	return nil
 }
 
+// updateFeedSeedState renames feeds in seed state, feeds the user can't edit
+// are skipped.
 func updateFeedSeedState(tx *gorm.DB, input *model.SeedStateInput) error {
 	for _, feedSeedStateInput := range input.FeedSeedState {
 		// Handler error in a soft way. If the feed doesn't exist, continue.
@@ -62,12 +64,32 @@ func updateFeedSeedState(tx *gorm.DB, input *model.SeedStateInput) error {
 		if res.RowsAffected != 1 {
 			continue
 		}
+		if !hasFeedRole(tx, &tmp, input.UserSeedState.ID, model.FeedCollaboratorRoleEditor) {
+			continue
+		}
+		if tmp.Name == feedSeedStateInput.Name {
+			continue
+		}
 		res = tx.Model(&model.Feed{}).Where("id = ?", feedSeedStateInput.ID).
 			Updates(model.Feed{Name: feedSeedStateInput.Name})
 		if res.Error != nil {
 			// Return error will rollback
 			return res.Error
 		}
+
+		// Rename is recorded as a new version like any other feed change.
+		var subSources []model.SubSource
+		if err := tx.Model(&tmp).Association("SubSources").Find(&subSources); err != nil {
+			return err
+		}
+		subSourceIds := []string{}
+		for _, subSource := range subSources {
+			subSourceIds = append(subSourceIds, subSource.Id)
+		}
+		tmp.Name = feedSeedStateInput.Name
+		if err := recordFeedVersion(tx, &tmp, subSourceIds, input.UserSeedState.ID, model.FeedVersionActionUpdate, nil); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -154,5 +176,11 @@ func getSeedStateById(db *gorm.DB, userId string) (*model.SeedState, error) {
 
 	ss := constructSeedStateFromUser(&user)
 
+	mutes, err := getUserMutes(db, userId)
+	if err != nil {
+		return nil, err
+	}
+	ss.Mutes = mutes
+
 	return ss, nil
 }
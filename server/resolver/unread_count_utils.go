@@ -0,0 +1,138 @@
+package resolver
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/utils"
+)
+
+const (
+	// Unread count stops at this limit, frontend displays it as "300+".
+	unreadCountLimit = 300
+	// Max number of post ids broadcasted to other devices in markFeedRead,
+	// other devices never have more than feedRefreshLimit posts loaded.
+	markFeedReadSignalLimit = feedRefreshLimit
+)
+
+// isPostRead combines per-post read status with feed read watermark, a post
+// below watermark is read unless explicitly marked as unread.
+func isPostRead(post *model.Post, readStatus bool, watermark int, hasWatermark bool, unreadExceptions map[string]bool) bool {
+	if readStatus {
+		return true
+	}
+	return hasWatermark && int(post.Cursor) <= watermark && !unreadExceptions[post.Id]
+}
+
+// getFeedUnreadCount counts unread posts of a feed for a user without loading
+// posts. Posts above watermark are checked against per-post read status, only
+// the newest unreadCountLimit of them are checked. Posts below watermark are
+// unread only if they're exceptions. Muted posts are not counted, same as
+// they're not listed.
+func getFeedUnreadCount(db *gorm.DB, r *utils.RedisStatusStore, userId string, feedId string, watermark int, unreadExceptions map[string]bool, mutes []*model.UserMute) (*model.FeedUnreadCount, error) {
+	res := &model.FeedUnreadCount{FeedID: feedId}
+
+	var postIds []string
+	if err := db.Model(&model.Post{}).
+		Joins("INNER JOIN post_feed_publishes ON post_feed_publishes.post_id = posts.id").
+		Where("post_feed_publishes.feed_id = ? AND posts.cursor > ?", feedId, watermark).
+		Scopes(muteScope(mutes)).
+		Order("posts.cursor desc").
+		Limit(unreadCountLimit+1).
+		Pluck("posts.id", &postIds).Error; err != nil {
+		return nil, err
+	}
+	if len(postIds) > unreadCountLimit {
+		postIds = postIds[:unreadCountLimit]
+		res.Truncated = true
+	}
+	status, err := r.GetItemsReadStatus(postIds, userId)
+	if err != nil {
+		return nil, err
+	}
+	for _, read := range status {
+		if !read {
+			res.Count++
+		}
+	}
+
+	if len(unreadExceptions) > 0 {
+		exceptionIds := []string{}
+		for pid := range unreadExceptions {
+			exceptionIds = append(exceptionIds, pid)
+		}
+		var count int64
+		if err := db.Model(&model.Post{}).
+			Joins("INNER JOIN post_feed_publishes ON post_feed_publishes.post_id = posts.id").
+			Where("post_feed_publishes.feed_id = ? AND posts.cursor <= ? AND posts.id IN ?", feedId, watermark, exceptionIds).
+			Scopes(muteScope(mutes)).
+			Count(&count).Error; err != nil {
+			return nil, err
+		}
+		res.Count += int(count)
+	}
+
+	if res.Count > unreadCountLimit {
+		res.Count = unreadCountLimit
+		res.Truncated = true
+	}
+	return res, nil
+}
+
+// getUnreadCounts returns unread count of each feed, in the order of feeds.
+func getUnreadCounts(db *gorm.DB, r *utils.RedisStatusStore, userId string, feeds []*model.Feed) ([]*model.FeedUnreadCount, error) {
+	watermarks, err := r.GetFeedReadWatermarks(userId)
+	if err != nil {
+		return nil, err
+	}
+	unreadExceptions, err := r.GetUnreadExceptions(userId)
+	if err != nil {
+		return nil, err
+	}
+	mutes, err := getUserMutes(db, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []*model.FeedUnreadCount{}
+	for _, feed := range feeds {
+		count, err := getFeedUnreadCount(db, r, userId, feed.Id, watermarks[feed.Id], unreadExceptions, mutes)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, count)
+	}
+	return res, nil
+}
+
+// getPostIdsUpToCursor returns the newest post ids of a feed with cursor <=
+// upToCursor, these are the posts affected by marking the feed as read.
+func getPostIdsUpToCursor(db *gorm.DB, feedId string, upToCursor int, limit int) ([]string, error) {
+	var postIds []string
+	err := db.Model(&model.Post{}).
+		Joins("INNER JOIN post_feed_publishes ON post_feed_publishes.post_id = posts.id").
+		Where("post_feed_publishes.feed_id = ? AND posts.cursor <= ?", feedId, upToCursor).
+		Order("posts.cursor desc").
+		Limit(limit).
+		Pluck("posts.id", &postIds).Error
+	return postIds, err
+}
+
+// getUnreadExceptionsUpToCursor returns exceptions of the user that are posts
+// of the feed with cursor <= upToCursor, these are no longer unread once the
+// feed is marked as read up to the cursor.
+func getUnreadExceptionsUpToCursor(db *gorm.DB, feedId string, upToCursor int, unreadExceptions map[string]bool) ([]string, error) {
+	postIds := []string{}
+	if len(unreadExceptions) == 0 {
+		return postIds, nil
+	}
+	exceptionIds := []string{}
+	for pid := range unreadExceptions {
+		exceptionIds = append(exceptionIds, pid)
+	}
+	err := db.Model(&model.Post{}).
+		Joins("INNER JOIN post_feed_publishes ON post_feed_publishes.post_id = posts.id").
+		Where("post_feed_publishes.feed_id = ? AND posts.cursor <= ? AND posts.id IN ?", feedId, upToCursor, exceptionIds).
+		Pluck("posts.id", &postIds).Error
+	return postIds, err
+}
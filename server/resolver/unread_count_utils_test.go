@@ -0,0 +1,23 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Luismorlan/newsmux/model"
+)
+
+func TestIsPostRead(t *testing.T) {
+	post := &model.Post{Id: "post_id", Cursor: 10}
+	noExceptions := map[string]bool{}
+
+	assert.True(t, isPostRead(post, true, 0, false, noExceptions))
+	assert.False(t, isPostRead(post, false, 0, false, noExceptions))
+	// Below or at watermark.
+	assert.True(t, isPostRead(post, false, 10, true, noExceptions))
+	// Above watermark.
+	assert.False(t, isPostRead(post, false, 9, true, noExceptions))
+	// Explicitly marked as unread after feed is marked as read.
+	assert.False(t, isPostRead(post, false, 10, true, map[string]bool{"post_id": true}))
+}
@@ -11,11 +11,21 @@ import (
 	"github.com/Luismorlan/newsmux/server/graph/generated"
 )
 
+func (r *personalAccessTokenResolver) Scopes(ctx context.Context, obj *model.PersonalAccessToken) ([]model.PersonalAccessTokenScope, error) {
+	return fromScopes(obj.Scopes), nil
+}
+
 func (r *userResolver) DeletedAt(ctx context.Context, obj *model.User) (*time.Time, error) {
 	return &obj.DeletedAt.Time, nil
 }
 
+// PersonalAccessToken returns generated.PersonalAccessTokenResolver implementation.
+func (r *Resolver) PersonalAccessToken() generated.PersonalAccessTokenResolver {
+	return &personalAccessTokenResolver{r}
+}
+
 // User returns generated.UserResolver implementation.
 func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
 
+type personalAccessTokenResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
@@ -0,0 +1,23 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/server/graph/generated"
+)
+
+func (r *userStateResolver) UnreadCounts(ctx context.Context, obj *model.UserState) ([]*model.FeedUnreadCount, error) {
+	if obj.User == nil {
+		return []*model.FeedUnreadCount{}, nil
+	}
+	return getUnreadCounts(r.DB, r.RedisStatusStore, obj.User.Id, obj.User.SubscribedFeeds)
+}
+
+// UserState returns generated.UserStateResolver implementation.
+func (r *Resolver) UserState() generated.UserStateResolver { return &userStateResolver{r} }
+
+type userStateResolver struct{ *Resolver }
@@ -0,0 +1,251 @@
+package server
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"html"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/feeds"
+	"gorm.io/gorm"
+
+	"github.com/Luismorlan/newsmux/model"
+	"github.com/Luismorlan/newsmux/utils"
+	. "github.com/Luismorlan/newsmux/utils/log"
+)
+
+const (
+	// How many latest posts are rendered in a syndicated feed.
+	syndicationPostsLimit = 50
+
+	// Max length of the title generated from post content, used when the post
+	// doesn't have a title (e.g. flash news).
+	syndicationTitleMaxRunes = 60
+
+	SyndicationFormatRss  = "rss"
+	SyndicationFormatAtom = "atom"
+	SyndicationFormatJson = "json"
+)
+
+var syndicationContentTypes = map[string]string{
+	SyndicationFormatRss:  "application/rss+xml; charset=utf-8",
+	SyndicationFormatAtom: "application/atom+xml; charset=utf-8",
+	SyndicationFormatJson: "application/feed+json; charset=utf-8",
+}
+
+// FeedSyndicationHandler renders the latest posts of a feed as RSS, Atom or
+// JSON Feed, so that newsmux feeds can be read by other tools. GLOBAL feeds
+// are open to everyone, PRIVATE feeds require the feed's syndication token in
+// query param "token". It must be registered outside of JWT middleware, since
+// RSS readers can't perform Cognito login.
+func FeedSyndicationHandler() gin.HandlerFunc {
+	db, err := utils.GetDBConnection()
+	if err != nil {
+		panic("failed to connect database")
+	}
+
+	return func(c *gin.Context) {
+		format := c.Param("format")
+		contentType, ok := syndicationContentTypes[format]
+		if !ok {
+			c.JSON(http.StatusNotFound, gin.H{"message": "unsupported feed format " + format})
+			return
+		}
+
+		var feed model.Feed
+		if db.Preload("Creator").First(&feed, "id = ?", c.Param("id")).RowsAffected != 1 ||
+			!canReadSyndicatedFeed(&feed, c.Query("token")) {
+			// Do not distinguish missing feed from wrong token, otherwise the
+			// endpoint leaks the existence of private feeds.
+			c.JSON(http.StatusNotFound, gin.H{"message": "feed not found"})
+			return
+		}
+
+		posts, err := getSyndicatedPosts(db, feed.Id)
+		if err != nil {
+			Log.Errorf("fail to get posts for syndicated feed %s: %v", feed.Id, err)
+			c.JSON(http.StatusInternalServerError, gin.H{"message": "fail to get posts"})
+			return
+		}
+
+		etag, err := syndicationETag(&feed, posts, format)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"message": "fail to compute etag"})
+			return
+		}
+		lastModified := syndicationLastModified(&feed, posts)
+		c.Header("ETag", etag)
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+		if isSyndicationNotModified(c.Request, etag, lastModified) {
+			c.Status(http.StatusNotModified)
+			return
+		}
+
+		body, err := renderSyndicatedFeed(buildSyndicatedFeed(&feed, posts, lastModified), format)
+		if err != nil {
+			Log.Errorf("fail to render feed %s as %s: %v", feed.Id, format, err)
+			c.JSON(http.StatusInternalServerError, gin.H{"message": "fail to render feed"})
+			return
+		}
+		c.Data(http.StatusOK, contentType, []byte(body))
+	}
+}
+
+func canReadSyndicatedFeed(feed *model.Feed, token string) bool {
+	if feed.Visibility == model.VisibilityGlobal {
+		return true
+	}
+	if feed.SyndicationToken == nil || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(*feed.SyndicationToken), []byte(token)) == 1
+}
+
+// getSyndicatedPosts returns the latest published posts of a feed, newest
+// first. Unlike the feeds API, we don't republish here: syndication only
+// reflects what is already in the feed.
+func getSyndicatedPosts(db *gorm.DB, feedId string) ([]*model.Post, error) {
+	var posts []*model.Post
+	err := db.Model(&model.Post{}).
+		Preload("SubSource").
+		Joins("INNER JOIN post_feed_publishes ON post_feed_publishes.post_id = posts.id").
+		Where("post_feed_publishes.feed_id = ?", feedId).
+		Order("posts.cursor desc").
+		Limit(syndicationPostsLimit).
+		Find(&posts).Error
+	return posts, err
+}
+
+// syndicationLastModified is the latest of feed setting change and newest
+// post creation.
+func syndicationLastModified(feed *model.Feed, posts []*model.Post) time.Time {
+	lastModified := feed.UpdatedAt
+	for _, post := range posts {
+		if post.CreatedAt.After(lastModified) {
+			lastModified = post.CreatedAt
+		}
+	}
+	return lastModified
+}
+
+// syndicationETag changes whenever the feed setting changes, or the set of
+// latest posts changes.
+func syndicationETag(feed *model.Feed, posts []*model.Post, format string) (string, error) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s|%s|%d", feed.Id, format, feed.UpdatedAt.UnixNano())
+	for _, post := range posts {
+		fmt.Fprintf(&b, "|%d", post.Cursor)
+	}
+	hash, err := utils.TextToMd5Hash(b.String())
+	if err != nil {
+		return "", err
+	}
+	return `"` + hash + `"`, nil
+}
+
+// isSyndicationNotModified implements conditional GET. If-None-Match takes
+// precedence over If-Modified-Since as required by RFC 7232.
+func isSyndicationNotModified(req *http.Request, etag string, lastModified time.Time) bool {
+	if inm := req.Header.Get("If-None-Match"); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == etag || candidate == "*" {
+				return true
+			}
+		}
+		return false
+	}
+	if ims := req.Header.Get("If-Modified-Since"); ims != "" {
+		t, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		// HTTP dates only have second precision.
+		return !lastModified.Truncate(time.Second).After(t)
+	}
+	return false
+}
+
+func buildSyndicatedFeed(feed *model.Feed, posts []*model.Post, lastModified time.Time) *feeds.Feed {
+	res := &feeds.Feed{
+		Id:          feed.Id,
+		Title:       feed.Name,
+		Link:        &feeds.Link{Href: "https://newsmux.com"},
+		Description: feed.Name,
+		Author:      &feeds.Author{Name: feed.Creator.Name},
+		Created:     feed.CreatedAt,
+		Updated:     lastModified,
+	}
+
+	for _, post := range posts {
+		item := &feeds.Item{
+			Id:          post.Id,
+			Title:       syndicatedItemTitle(post),
+			Link:        &feeds.Link{Href: post.OriginUrl},
+			Author:      &feeds.Author{Name: post.SubSource.Name},
+			Description: post.Content,
+			Content:     syndicatedItemContent(post),
+			Created:     post.ContentGeneratedAt,
+			Updated:     post.ContentGeneratedAt,
+		}
+		if len(post.ImageUrls) > 0 {
+			item.Enclosure = &feeds.Enclosure{
+				Url: post.ImageUrls[0],
+				// Length is required by RSS spec but unknown to us.
+				Length: "0",
+				Type:   "image/" + syndicatedImageType(post.ImageUrls[0]),
+			}
+		}
+		res.Add(item)
+	}
+	return res
+}
+
+func syndicatedItemTitle(post *model.Post) string {
+	if post.Title != "" {
+		return post.Title
+	}
+	content := []rune(strings.TrimSpace(post.Content))
+	if len(content) > syndicationTitleMaxRunes {
+		return string(content[:syndicationTitleMaxRunes]) + "..."
+	}
+	return string(content)
+}
+
+// syndicatedItemContent renders post content and all images as html.
+func syndicatedItemContent(post *model.Post) string {
+	var b strings.Builder
+	for _, line := range strings.Split(post.Content, "\n") {
+		b.WriteString("<p>" + html.EscapeString(line) + "</p>")
+	}
+	for _, url := range post.ImageUrls {
+		b.WriteString(`<img src="` + html.EscapeString(url) + `"/>`)
+	}
+	return b.String()
+}
+
+func syndicatedImageType(url string) string {
+	ext := strings.TrimPrefix(strings.ToLower(utils.GetUrlExtNameWithDot(url)), ".")
+	switch ext {
+	case "png", "gif", "webp":
+		return ext
+	default:
+		return "jpeg"
+	}
+}
+
+func renderSyndicatedFeed(feed *feeds.Feed, format string) (string, error) {
+	switch format {
+	case SyndicationFormatRss:
+		return feed.ToRss()
+	case SyndicationFormatAtom:
+		return feed.ToAtom()
+	case SyndicationFormatJson:
+		return feed.ToJSON()
+	default:
+		return "", fmt.Errorf("unsupported feed format %s", format)
+	}
+}
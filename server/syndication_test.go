@@ -0,0 +1,61 @@
+package server
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Luismorlan/newsmux/model"
+)
+
+func TestCanReadSyndicatedFeed(t *testing.T) {
+	token := "secret"
+	assert.True(t, canReadSyndicatedFeed(&model.Feed{Visibility: model.VisibilityGlobal}, ""))
+	assert.False(t, canReadSyndicatedFeed(&model.Feed{Visibility: model.VisibilityPrivate}, ""))
+	assert.False(t, canReadSyndicatedFeed(&model.Feed{Visibility: model.VisibilityPrivate}, "secret"))
+	assert.False(t, canReadSyndicatedFeed(&model.Feed{Visibility: model.VisibilityPrivate, SyndicationToken: &token}, "wrong"))
+	assert.True(t, canReadSyndicatedFeed(&model.Feed{Visibility: model.VisibilityPrivate, SyndicationToken: &token}, "secret"))
+}
+
+func TestIsSyndicationNotModified(t *testing.T) {
+	lastModified := time.Date(2021, 10, 1, 8, 0, 0, 500, time.UTC)
+
+	req, _ := http.NewRequest("GET", "/feeds/id/rss", nil)
+	assert.False(t, isSyndicationNotModified(req, `"abc"`, lastModified))
+
+	req.Header.Set("If-None-Match", `W/"abc"`)
+	assert.True(t, isSyndicationNotModified(req, `"abc"`, lastModified))
+	assert.False(t, isSyndicationNotModified(req, `"def"`, lastModified))
+
+	req.Header.Del("If-None-Match")
+	req.Header.Set("If-Modified-Since", lastModified.Format(http.TimeFormat))
+	assert.True(t, isSyndicationNotModified(req, `"abc"`, lastModified))
+	assert.False(t, isSyndicationNotModified(req, `"abc"`, lastModified.Add(time.Minute)))
+}
+
+func TestBuildSyndicatedFeed(t *testing.T) {
+	now := time.Now()
+	feed := &model.Feed{Id: "feed_id", Name: "feed"}
+	posts := []*model.Post{
+		{
+			Id:                 "post_1",
+			Content:            "a flash news without title",
+			OriginUrl:          "https://example.com/1",
+			ContentGeneratedAt: now,
+			ImageUrls:          []string{"https://example.com/1.png"},
+		},
+	}
+
+	res := buildSyndicatedFeed(feed, posts, now)
+	assert.Equal(t, 1, len(res.Items))
+	assert.Equal(t, "a flash news without title", res.Items[0].Title)
+	assert.Equal(t, "image/png", res.Items[0].Enclosure.Type)
+
+	for _, format := range []string{SyndicationFormatRss, SyndicationFormatAtom, SyndicationFormatJson} {
+		body, err := renderSyndicatedFeed(res, format)
+		assert.Nil(t, err)
+		assert.Contains(t, body, "https://example.com/1")
+	}
+}
@@ -195,7 +195,27 @@ func DatabaseSetupAndMigration(db *gorm.DB) {
 		panic("failed to connect database")
 	}
 
-	db.AutoMigrate(&model.Feed{}, &model.User{}, &model.Post{}, &model.Source{}, &model.SubSource{})
+	db.AutoMigrate(&model.Feed{}, &model.User{}, &model.Post{}, &model.Source{}, &model.SubSource{}, &model.FeedCollaborator{}, &model.UserMute{}, &model.PersonalAccessToken{}, &model.FeedVersion{}, &model.PanopticTaskRun{}, &model.PanopticLeaderLease{}, &model.PanopticJobPause{})
+
+	if err = backfillFeedBaseVersions(db); err != nil {
+		panic("failed to backfill feed versions")
+	}
+}
+
+// backfillFeedBaseVersions snapshots feeds created before feed versioning as
+// version 0, so that their first edit can be diffed and rolled back. Feeds
+// with any version are skipped, it's safe to run on every startup.
+func backfillFeedBaseVersions(db *gorm.DB) error {
+	return db.Exec(`INSERT INTO feed_versions (id, created_at, feed_id, version, author_id, action,
+			name, filter_data_expression, visibility, sub_source_ids)
+		SELECT feeds.id || '_v0', feeds.updated_at, feeds.id, 0,
+			(SELECT users.id FROM users WHERE users.id = feeds.creator_id), ?,
+			feeds.name, feeds.filter_data_expression, feeds.visibility,
+			ARRAY(SELECT sub_source_id FROM feed_subsources
+				WHERE feed_subsources.feed_id = feeds.id ORDER BY sub_source_id)
+		FROM feeds
+		WHERE NOT EXISTS (SELECT 1 FROM feed_versions WHERE feed_versions.feed_id = feeds.id)`,
+		model.FeedVersionActionCreate).Error
 }
 
 // IsDatabaseExist returns true on DB exist, returns false on not exist or error
@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/Luismorlan/newsmux/model"
 	"github.com/Luismorlan/newsmux/utils/dotenv"
 	"github.com/stretchr/testify/assert"
 )
@@ -36,3 +37,34 @@ func TestIsDatabaseExist(t *testing.T) {
 	assert.Nil(t, err)
 	assert.False(t, exists)
 }
+
+func TestBackfillFeedBaseVersions(t *testing.T) {
+	db, _ := CreateTempDB(t)
+
+	user := model.User{Id: "user_id", Name: "user_name"}
+	assert.Nil(t, db.Create(&user).Error)
+	source := model.Source{Id: "source_id", Name: "source_name", Creator: user}
+	assert.Nil(t, db.Create(&source).Error)
+	subSource := model.SubSource{Id: "subsource_id", Name: "subsource_name", SourceID: source.Id}
+	assert.Nil(t, db.Create(&subSource).Error)
+	feed := model.Feed{
+		Id:                   "feed_id",
+		Creator:              user,
+		Name:                 "old_feed",
+		FilterDataExpression: []byte(`{"a":1}`),
+		SubSources:           []*model.SubSource{&subSource},
+	}
+	assert.Nil(t, db.Create(&feed).Error)
+
+	assert.Nil(t, backfillFeedBaseVersions(db))
+	// Running again doesn't add more versions.
+	assert.Nil(t, backfillFeedBaseVersions(db))
+
+	versions := []model.FeedVersion{}
+	assert.Nil(t, db.Where("feed_id = ?", feed.Id).Find(&versions).Error)
+	assert.Equal(t, 1, len(versions))
+	assert.Equal(t, 0, versions[0].Version)
+	assert.Equal(t, "user_id", *versions[0].AuthorID)
+	assert.Equal(t, "old_feed", versions[0].Name)
+	assert.Equal(t, []string{"subsource_id"}, []string(versions[0].SubSourceIds))
+}
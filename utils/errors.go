@@ -3,4 +3,6 @@ package utils
 const (
 	// User didn't provide any token, or the token is invalid
 	ErrorTokenAuthFail = 10001
+	// User or ip sent too many requests, should retry after retryAfterSeconds
+	ErrorRateLimited = 10002
 )
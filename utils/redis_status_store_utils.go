@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,6 +20,10 @@ const (
 	// Redis only has string type, there is no boolean or int, so we use "1" to represent true
 	RedisTrue  = "1"
 	RedisFalse = "0"
+
+	// Unread exceptions of a user expire if the user doesn't mark anything as
+	// unread for this long, posts that old are considered read.
+	UnreadExceptionsTTL = 30 * 24 * time.Hour
 )
 
 var ctx = context.Background()
@@ -112,6 +117,9 @@ func (r RedisStatusStore) SetItemsReadStatus(itemNodeIds []string, userId string
 		if err != nil {
 			return err
 		}
+		if err := r.inner.SRem(ctx, r.unreadExceptionsKey(userId), toInterfaces(itemNodeIds)...).Err(); err != nil {
+			return err
+		}
 		for _, key := range keys {
 			if err := r.inner.Expire(ctx, key, time.Hour*24*7).Err(); err != nil {
 				return err
@@ -124,5 +132,166 @@ func (r RedisStatusStore) SetItemsReadStatus(itemNodeIds []string, userId string
 	for _, pid := range itemNodeIds {
 		keyValues = append(keyValues, r.keyParser.MustEncodePostKey(userId, pid))
 	}
-	return r.inner.Del(ctx, keyValues...).Err()
+	if err := r.inner.Del(ctx, keyValues...).Err(); err != nil {
+		return err
+	}
+	// Item might be below its feed's read watermark, record it as an exception
+	// so that it stays unread.
+	key := r.unreadExceptionsKey(userId)
+	if err := r.inner.SAdd(ctx, key, toInterfaces(itemNodeIds)...).Err(); err != nil {
+		return err
+	}
+	return r.inner.Expire(ctx, key, UnreadExceptionsTTL).Err()
+}
+
+func toInterfaces(strs []string) []interface{} {
+	res := []interface{}{}
+	for _, s := range strs {
+		res = append(res, s)
+	}
+	return res
+}
+
+func (r RedisKeyParser) encodePrefixedKey(prefix string, id string) string {
+	if !r.ValidateId(id) {
+		panic(fmt.Errorf("invalid id with delimiter: %s, %s", id, r.delimiter))
+	}
+	return fmt.Sprintf("%s%s%s", prefix, r.delimiter, id)
+}
+
+// Feed read watermarks of a user are kept in a hash, from feed id to the
+// cursor up to which all posts of the feed are read.
+func (r *RedisStatusStore) watermarksKey(userId string) string {
+	return r.keyParser.encodePrefixedKey("read_watermarks", userId)
+}
+
+// Posts explicitly marked as unread by a user are kept in a set, these are
+// exceptions to feed read watermarks.
+func (r *RedisStatusStore) unreadExceptionsKey(userId string) string {
+	return r.keyParser.encodePrefixedKey("unread_exceptions", userId)
+}
+
+// GetFeedReadWatermarks returns all feeds' read watermarks of a user, feeds
+// never marked as read are absent.
+func (r *RedisStatusStore) GetFeedReadWatermarks(userId string) (map[string]int, error) {
+	res, err := r.inner.HGetAll(ctx, r.watermarksKey(userId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	watermarks := map[string]int{}
+	for feedId, v := range res {
+		cursor, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid watermark %s for feed %s: %v", v, feedId, err)
+		}
+		watermarks[feedId] = cursor
+	}
+	return watermarks, nil
+}
+
+// AdvanceFeedReadWatermark marks all posts of a feed up to cursor as read,
+// watermark never moves backward. postIds are the exceptions among posts of
+// the feed up to cursor, which are no longer unread.
+func (r *RedisStatusStore) AdvanceFeedReadWatermark(userId string, feedId string, cursor int, postIds []string) error {
+	key := r.watermarksKey(userId)
+	err := r.inner.Watch(ctx, func(tx *redis.Tx) error {
+		current, err := tx.HGet(ctx, key, feedId).Int()
+		if err != nil && err != redis.Nil {
+			return err
+		}
+		if err == nil && current >= cursor {
+			return nil
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.HSet(ctx, key, feedId, cursor)
+			return nil
+		})
+		return err
+	}, key)
+	if err != nil {
+		return err
+	}
+	if len(postIds) == 0 {
+		return nil
+	}
+	return r.inner.SRem(ctx, r.unreadExceptionsKey(userId), toInterfaces(postIds)...).Err()
+}
+
+// GetUnreadExceptions returns posts explicitly marked as unread by the user.
+func (r *RedisStatusStore) GetUnreadExceptions(userId string) (map[string]bool, error) {
+	res, err := r.inner.SMembers(ctx, r.unreadExceptionsKey(userId)).Result()
+	if err != nil {
+		return nil, err
+	}
+	exceptions := map[string]bool{}
+	for _, pid := range res {
+		exceptions[pid] = true
+	}
+	return exceptions, nil
+}
+
+// GetCache returns a cached value set by SetCache, false if absent or expired.
+func (r *RedisStatusStore) GetCache(key string) (string, bool, error) {
+	res, err := r.inner.Get(ctx, r.keyParser.encodePrefixedKey("cache", key)).Result()
+	if err == redis.Nil {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return res, true, nil
+}
+
+// SetCache caches a value which expires after ttl.
+func (r *RedisStatusStore) SetCache(key string, value string, ttl time.Duration) error {
+	return r.inner.Set(ctx, r.keyParser.encodePrefixedKey("cache", key), value, ttl).Err()
+}
+
+// RateLimit is a token bucket refilled at Rate tokens per second, holding at
+// most Burst tokens.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// tokenBucketScript takes a token from the bucket at KEYS[1] atomically, so
+// that replicas share the same bucket. Returns {allowed, retry after ms}.
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1]) or burst
+local ts = tonumber(state[2]) or now
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, wait}
+`)
+
+// AllowRequest takes a token from the bucket of id within a named bucket
+// group, returns false and how long to wait if the bucket is empty.
+func (r *RedisStatusStore) AllowRequest(bucket string, id string, limit RateLimit) (bool, time.Duration, error) {
+	// Ids are client provided (e.g. ip), key is never decoded so no validation.
+	key := fmt.Sprintf("rate_limit%s%s%s%s", r.keyParser.delimiter, bucket, r.keyParser.delimiter, id)
+	res, err := tokenBucketScript.Run(ctx, r.inner, []string{key},
+		limit.Rate, limit.Burst, time.Now().UnixNano()/int64(time.Millisecond)).Result()
+	if err != nil {
+		return false, 0, err
+	}
+	values, ok := res.([]interface{})
+	if !ok || len(values) != 2 {
+		return false, 0, fmt.Errorf("unexpected token bucket result %v", res)
+	}
+	allowed, _ := values[0].(int64)
+	wait, _ := values[1].(int64)
+	return allowed == 1, time.Duration(wait) * time.Millisecond, nil
 }
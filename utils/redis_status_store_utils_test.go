@@ -2,6 +2,7 @@ package utils
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -70,3 +71,62 @@ func TestRedisStatusStore(t *testing.T) {
 		assert.False(t, s)
 	}
 }
+
+func TestFeedReadWatermark(t *testing.T) {
+	r, err := GetRedisStatusStore()
+	assert.Nil(t, err)
+
+	userId := "watermark-user-id"
+	r.inner.Del(ctx, r.watermarksKey(userId), r.unreadExceptionsKey(userId))
+
+	watermarks, err := r.GetFeedReadWatermarks(userId)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(watermarks))
+
+	assert.Nil(t, r.AdvanceFeedReadWatermark(userId, "feed-id", 10, []string{}))
+	// Watermark never moves backward.
+	assert.Nil(t, r.AdvanceFeedReadWatermark(userId, "feed-id", 5, []string{}))
+	watermarks, err = r.GetFeedReadWatermarks(userId)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]int{"feed-id": 10}, watermarks)
+
+	// Marking unread records an exception, marking read again or advancing
+	// watermark over it removes the exception.
+	assert.Nil(t, r.SetItemsReadStatus([]string{"post-1", "post-2"}, userId, false))
+	exceptions, err := r.GetUnreadExceptions(userId)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]bool{"post-1": true, "post-2": true}, exceptions)
+	ttl, err := r.inner.TTL(ctx, r.unreadExceptionsKey(userId)).Result()
+	assert.Nil(t, err)
+	assert.Greater(t, ttl, time.Duration(0))
+	assert.LessOrEqual(t, ttl, UnreadExceptionsTTL)
+
+	assert.Nil(t, r.SetItemsReadStatus([]string{"post-1"}, userId, true))
+	assert.Nil(t, r.AdvanceFeedReadWatermark(userId, "feed-id", 20, []string{"post-2"}))
+	exceptions, err = r.GetUnreadExceptions(userId)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(exceptions))
+}
+
+func TestAllowRequest(t *testing.T) {
+	r, err := GetRedisStatusStore()
+	assert.Nil(t, err)
+
+	limit := RateLimit{Rate: 1, Burst: 2}
+	r.inner.Del(ctx, "rate_limit__test__rate-limit-user-id", "rate_limit__test__other-user-id")
+
+	for i := 0; i < limit.Burst; i++ {
+		allowed, _, err := r.AllowRequest("test", "rate-limit-user-id", limit)
+		assert.Nil(t, err)
+		assert.True(t, allowed)
+	}
+	allowed, retryAfter, err := r.AllowRequest("test", "rate-limit-user-id", limit)
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+	assert.True(t, retryAfter > 0 && retryAfter <= time.Second)
+
+	// Buckets are independent.
+	allowed, _, err = r.AllowRequest("test", "other-user-id", limit)
+	assert.Nil(t, err)
+	assert.True(t, allowed)
+}